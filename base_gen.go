@@ -3951,11 +3951,11 @@ var baseList = []*bitmap.Image{
 			0b10000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00100000, 0b10000000,
 			0b10111010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00101110, 0b10000000,
 			0b10111010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00101110, 0b10000000,
-			0b10111010, 0b00000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00011111, 0b00000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b00101110, 0b10000000,
-			0b10000010, 0b00000000, 0b00000000, 0b10001000, 0b00000000, 0b00000000, 0b00100010, 0b00000000, 0b00000000, 0b00010001, 0b00000000, 0b00000000, 0b00000010, 0b00100000, 0b00000000, 0b00000000, 0b00100000, 0b10000000,
-			0b11111110, 0b10101010, 0b10101010, 0b10101010, 0b10101010, 0b10101010, 0b10101010, 0b10101010, 0b10101010, 0b10110101, 0b10101010, 0b10101010, 0b10101010, 0b10101010, 0b10101010, 0b10101010, 0b10111111, 0b10000000,
-			0b00000000, 0b00000000, 0b00000000, 0b10001000, 0b00000000, 0b00000000, 0b00100010, 0b00000000, 0b00000000, 0b00010001, 0b00000000, 0b00000000, 0b00000010, 0b00100000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
-			0b00000010, 0b00000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00011111, 0b00000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
+			0b10111010, 0b00000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b00101110, 0b10000000,
+			0b10000010, 0b00000000, 0b00000000, 0b10001000, 0b00000000, 0b00000000, 0b00100010, 0b00000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00000010, 0b00100000, 0b00000000, 0b00000000, 0b00100000, 0b10000000,
+			0b11111110, 0b10101010, 0b10101010, 0b10101010, 0b10101010, 0b10101010, 0b10101010, 0b10101010, 0b10101010, 0b10101010, 0b10101010, 0b10101010, 0b10101010, 0b10101010, 0b10101010, 0b10101010, 0b10111111, 0b10000000,
+			0b00000000, 0b00000000, 0b00000000, 0b10001000, 0b00000000, 0b00000000, 0b00100010, 0b00000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00000010, 0b00100000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
+			0b00000010, 0b00000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
@@ -3971,11 +3971,11 @@ var baseList = []*bitmap.Image{
 			0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
-			0b00001111, 0b10000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00011111, 0b00000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
-			0b00001000, 0b10000000, 0b00000000, 0b10001000, 0b00000000, 0b00000000, 0b00100010, 0b00000000, 0b00000000, 0b00010001, 0b00000000, 0b00000000, 0b00000010, 0b00100000, 0b00000000, 0b00000000, 0b10001000, 0b00000000,
-			0b00001010, 0b10000000, 0b00000000, 0b10101000, 0b00000000, 0b00000000, 0b00101010, 0b00000000, 0b00000000, 0b00010101, 0b00000000, 0b00000000, 0b00000010, 0b10100000, 0b00000000, 0b00000000, 0b10101000, 0b00000000,
-			0b00001000, 0b10000000, 0b00000000, 0b10001000, 0b00000000, 0b00000000, 0b00100010, 0b00000000, 0b00000000, 0b00010001, 0b00000000, 0b00000000, 0b00000010, 0b00100000, 0b00000000, 0b00000000, 0b10001000, 0b00000000,
-			0b00001111, 0b10000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00011111, 0b00000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
+			0b00001111, 0b10000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
+			0b00001000, 0b10000000, 0b00000000, 0b10001000, 0b00000000, 0b00000000, 0b00100010, 0b00000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00000010, 0b00100000, 0b00000000, 0b00000000, 0b10001000, 0b00000000,
+			0b00001010, 0b10000000, 0b00000000, 0b10101000, 0b00000000, 0b00000000, 0b00101010, 0b00000000, 0b00000000, 0b00001010, 0b10000000, 0b00000000, 0b00000010, 0b10100000, 0b00000000, 0b00000000, 0b10101000, 0b00000000,
+			0b00001000, 0b10000000, 0b00000000, 0b10001000, 0b00000000, 0b00000000, 0b00100010, 0b00000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00000010, 0b00100000, 0b00000000, 0b00000000, 0b10001000, 0b00000000,
+			0b00001111, 0b10000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
 			0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
@@ -3997,11 +3997,11 @@ var baseList = []*bitmap.Image{
 			0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
-			0b00001111, 0b10000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00011111, 0b00000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
-			0b00001000, 0b10000000, 0b00000000, 0b10001000, 0b00000000, 0b00000000, 0b00100010, 0b00000000, 0b00000000, 0b00010001, 0b00000000, 0b00000000, 0b00000010, 0b00100000, 0b00000000, 0b00000000, 0b10001000, 0b00000000,
-			0b00001010, 0b10000000, 0b00000000, 0b10101000, 0b00000000, 0b00000000, 0b00101010, 0b00000000, 0b00000000, 0b00010101, 0b00000000, 0b00000000, 0b00000010, 0b10100000, 0b00000000, 0b00000000, 0b10101000, 0b00000000,
-			0b00001000, 0b10000000, 0b00000000, 0b10001000, 0b00000000, 0b00000000, 0b00100010, 0b00000000, 0b00000000, 0b00010001, 0b00000000, 0b00000000, 0b00000010, 0b00100000, 0b00000000, 0b00000000, 0b10001000, 0b00000000,
-			0b00001111, 0b10000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00011111, 0b00000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
+			0b00001111, 0b10000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
+			0b00001000, 0b10000000, 0b00000000, 0b10001000, 0b00000000, 0b00000000, 0b00100010, 0b00000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00000010, 0b00100000, 0b00000000, 0b00000000, 0b10001000, 0b00000000,
+			0b00001010, 0b10000000, 0b00000000, 0b10101000, 0b00000000, 0b00000000, 0b00101010, 0b00000000, 0b00000000, 0b00001010, 0b10000000, 0b00000000, 0b00000010, 0b10100000, 0b00000000, 0b00000000, 0b10101000, 0b00000000,
+			0b00001000, 0b10000000, 0b00000000, 0b10001000, 0b00000000, 0b00000000, 0b00100010, 0b00000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00000010, 0b00100000, 0b00000000, 0b00000000, 0b10001000, 0b00000000,
+			0b00001111, 0b10000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
 			0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
@@ -4022,12 +4022,12 @@ var baseList = []*bitmap.Image{
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
-			0b00001111, 0b10000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00011111, 0b00000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
-			0b00001000, 0b10000000, 0b00000000, 0b10001000, 0b00000000, 0b00000000, 0b00100010, 0b00000000, 0b00000000, 0b00010001, 0b00000000, 0b00000000, 0b00000010, 0b00100000, 0b00000000, 0b00000000, 0b10001000, 0b00000000,
-			0b00001010, 0b10000000, 0b00000000, 0b10101000, 0b00000000, 0b00000000, 0b00101010, 0b00000000, 0b00000000, 0b00010101, 0b00000000, 0b00000000, 0b00000010, 0b10100000, 0b00000000, 0b00000000, 0b10101000, 0b00000000,
-			0b00001000, 0b10000000, 0b00000000, 0b10001000, 0b00000000, 0b00000000, 0b00100010, 0b00000000, 0b00000000, 0b00010001, 0b00000000, 0b00000000, 0b00000010, 0b00100000, 0b00000000, 0b00000000, 0b10001000, 0b00000000,
-			0b00001111, 0b10000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00011111, 0b00000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
-			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
+			0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
+			0b00001111, 0b10000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
+			0b00001000, 0b10000000, 0b00000000, 0b10001000, 0b00000000, 0b00000000, 0b00100010, 0b00000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00000010, 0b00100000, 0b00000000, 0b00000000, 0b10001000, 0b00000000,
+			0b00001010, 0b10000000, 0b00000000, 0b10101000, 0b00000000, 0b00000000, 0b00101010, 0b00000000, 0b00000000, 0b00001010, 0b10000000, 0b00000000, 0b00000010, 0b10100000, 0b00000000, 0b00000000, 0b10101000, 0b00000000,
+			0b00001000, 0b10000000, 0b00000000, 0b10001000, 0b00000000, 0b00000000, 0b00100010, 0b00000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00000010, 0b00100000, 0b00000000, 0b00000000, 0b10001000, 0b00000000,
+			0b00001111, 0b10000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
 			0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
@@ -4049,11 +4049,11 @@ var baseList = []*bitmap.Image{
 			0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
-			0b00001111, 0b10000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00011111, 0b00000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
-			0b00001000, 0b10000000, 0b00000000, 0b10001000, 0b00000000, 0b00000000, 0b00100010, 0b00000000, 0b00000000, 0b00010001, 0b00000000, 0b00000000, 0b00000010, 0b00100000, 0b00000000, 0b00000000, 0b10001000, 0b00000000,
-			0b00001010, 0b10000000, 0b00000000, 0b10101000, 0b00000000, 0b00000000, 0b00101010, 0b00000000, 0b00000000, 0b00010101, 0b00000000, 0b00000000, 0b00000010, 0b10100000, 0b00000000, 0b00000000, 0b10101000, 0b00000000,
-			0b00001000, 0b10000000, 0b00000000, 0b10001000, 0b00000000, 0b00000000, 0b00100010, 0b00000000, 0b00000000, 0b00010001, 0b00000000, 0b00000000, 0b00000010, 0b00100000, 0b00000000, 0b00000000, 0b10001000, 0b00000000,
-			0b00001111, 0b10000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00011111, 0b00000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
+			0b00001111, 0b10000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
+			0b00001000, 0b10000000, 0b00000000, 0b10001000, 0b00000000, 0b00000000, 0b00100010, 0b00000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00000010, 0b00100000, 0b00000000, 0b00000000, 0b10001000, 0b00000000,
+			0b00001010, 0b10000000, 0b00000000, 0b10101000, 0b00000000, 0b00000000, 0b00101010, 0b00000000, 0b00000000, 0b00001010, 0b10000000, 0b00000000, 0b00000010, 0b10100000, 0b00000000, 0b00000000, 0b10101000, 0b00000000,
+			0b00001000, 0b10000000, 0b00000000, 0b10001000, 0b00000000, 0b00000000, 0b00100010, 0b00000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00000010, 0b00100000, 0b00000000, 0b00000000, 0b10001000, 0b00000000,
+			0b00001111, 0b10000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
 			0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
@@ -4075,11 +4075,11 @@ var baseList = []*bitmap.Image{
 			0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
-			0b00000010, 0b00000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00011111, 0b00000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
-			0b00000000, 0b00000000, 0b00000000, 0b10001000, 0b00000000, 0b00000000, 0b00100010, 0b00000000, 0b00000000, 0b00010001, 0b00000000, 0b00000000, 0b00000010, 0b00100000, 0b00000000, 0b00000000, 0b10001000, 0b00000000,
-			0b11111110, 0b00000000, 0b00000000, 0b10101000, 0b00000000, 0b00000000, 0b00101010, 0b00000000, 0b00000000, 0b00010101, 0b00000000, 0b00000000, 0b00000010, 0b10100000, 0b00000000, 0b00000000, 0b10101000, 0b00000000,
-			0b10000010, 0b00000000, 0b00000000, 0b10001000, 0b00000000, 0b00000000, 0b00100010, 0b00000000, 0b00000000, 0b00010001, 0b00000000, 0b00000000, 0b00000010, 0b00100000, 0b00000000, 0b00000000, 0b10001000, 0b00000000,
-			0b10111010, 0b00000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00011111, 0b00000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
+			0b00000010, 0b00000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
+			0b00000000, 0b00000000, 0b00000000, 0b10001000, 0b00000000, 0b00000000, 0b00100010, 0b00000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00000010, 0b00100000, 0b00000000, 0b00000000, 0b10001000, 0b00000000,
+			0b11111110, 0b00000000, 0b00000000, 0b10101000, 0b00000000, 0b00000000, 0b00101010, 0b00000000, 0b00000000, 0b00001010, 0b10000000, 0b00000000, 0b00000010, 0b10100000, 0b00000000, 0b00000000, 0b10101000, 0b00000000,
+			0b10000010, 0b00000000, 0b00000000, 0b10001000, 0b00000000, 0b00000000, 0b00100010, 0b00000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00000010, 0b00100000, 0b00000000, 0b00000000, 0b10001000, 0b00000000,
+			0b10111010, 0b00000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
 			0b10111010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b10111010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b10000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
@@ -4716,11 +4716,11 @@ var baseList = []*bitmap.Image{
 			0b10000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000010, 0b00001000,
 			0b10111010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000010, 0b11101000,
 			0b10111010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000010, 0b11101000,
-			0b10111010, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00001111, 0b10000000, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000010, 0b11101000,
-			0b10000010, 0b00000000, 0b00000000, 0b00001000, 0b10000000, 0b00001000, 0b10000000, 0b00000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00000010, 0b00001000,
+			0b10111010, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000010, 0b11101000,
+			0b10000010, 0b00000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00000010, 0b00001000,
 			0b11111110, 0b10101010, 0b10101010, 0b10101010, 0b10101010, 0b10101010, 0b10101010, 0b10101010, 0b10101010, 0b10101010, 0b10101010, 0b10101010, 0b10101010, 0b10101010, 0b10101010, 0b10101010, 0b10101010, 0b10101010, 0b10101011, 0b11111000,
-			0b00000000, 0b00000000, 0b00000000, 0b00001000, 0b10000000, 0b00001000, 0b10000000, 0b00000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00000000, 0b00000000,
-			0b00000010, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00001111, 0b10000000, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000000, 0b00000000,
+			0b00000000, 0b00000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00000000, 0b00000000,
+			0b00000010, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
@@ -4740,11 +4740,11 @@ var baseList = []*bitmap.Image{
 			0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
-			0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00001111, 0b10000000, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000,
-			0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000, 0b00001000, 0b10000000, 0b00000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000,
-			0b00001010, 0b10000000, 0b00000000, 0b00001010, 0b10000000, 0b00001010, 0b10000000, 0b00000000, 0b00000000, 0b00001010, 0b10000000, 0b00000000, 0b00001010, 0b10000000, 0b00000000, 0b00001010, 0b10000000, 0b00000000, 0b00001010, 0b10000000,
-			0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000, 0b00001000, 0b10000000, 0b00000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000,
-			0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00001111, 0b10000000, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000,
+			0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000,
+			0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000,
+			0b00001010, 0b10000000, 0b00000000, 0b00001010, 0b10000000, 0b00000000, 0b00001010, 0b10000000, 0b00000000, 0b00001010, 0b10000000, 0b00000000, 0b00001010, 0b10000000, 0b00000000, 0b00001010, 0b10000000, 0b00000000, 0b00001010, 0b10000000,
+			0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000,
+			0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000,
 			0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
@@ -4756,12 +4756,6 @@ var baseList = []*bitmap.Image{
 			0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
-			0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00001111, 0b10000000, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000,
-			0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000, 0b00001000, 0b10000000, 0b00000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000,
-			0b00001010, 0b10000000, 0b00000000, 0b00001010, 0b10000000, 0b00001010, 0b10000000, 0b00000000, 0b00000000, 0b00001010, 0b10000000, 0b00000000, 0b00001010, 0b10000000, 0b00000000, 0b00001010, 0b10000000, 0b00000000, 0b00001010, 0b10000000,
-			0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000, 0b00001000, 0b10000000, 0b00000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000,
-			0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00001111, 0b10000000, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000,
-			0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
@@ -4770,6 +4764,12 @@ var baseList = []*bitmap.Image{
 			0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
+			0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000,
+			0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000,
+			0b00001010, 0b10000000, 0b00000000, 0b00001010, 0b10000000, 0b00000000, 0b00001010, 0b10000000, 0b00000000, 0b00001010, 0b10000000, 0b00000000, 0b00001010, 0b10000000, 0b00000000, 0b00001010, 0b10000000, 0b00000000, 0b00001010, 0b10000000,
+			0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000,
+			0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000,
+			0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
@@ -4788,11 +4788,11 @@ var baseList = []*bitmap.Image{
 			0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
-			0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00001111, 0b10000000, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000,
-			0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000, 0b00001000, 0b10000000, 0b00000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000,
-			0b00001010, 0b10000000, 0b00000000, 0b00001010, 0b10000000, 0b00001010, 0b10000000, 0b00000000, 0b00000000, 0b00001010, 0b10000000, 0b00000000, 0b00001010, 0b10000000, 0b00000000, 0b00001010, 0b10000000, 0b00000000, 0b00001010, 0b10000000,
-			0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000, 0b00001000, 0b10000000, 0b00000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000,
-			0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00001111, 0b10000000, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000,
+			0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000,
+			0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000,
+			0b00001010, 0b10000000, 0b00000000, 0b00001010, 0b10000000, 0b00000000, 0b00001010, 0b10000000, 0b00000000, 0b00001010, 0b10000000, 0b00000000, 0b00001010, 0b10000000, 0b00000000, 0b00001010, 0b10000000, 0b00000000, 0b00001010, 0b10000000,
+			0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000,
+			0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000,
 			0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
@@ -4812,11 +4812,11 @@ var baseList = []*bitmap.Image{
 			0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
-			0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00001111, 0b10000000, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000,
-			0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000, 0b00001000, 0b10000000, 0b00000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000,
-			0b00001010, 0b10000000, 0b00000000, 0b00001010, 0b10000000, 0b00001010, 0b10000000, 0b00000000, 0b00000000, 0b00001010, 0b10000000, 0b00000000, 0b00001010, 0b10000000, 0b00000000, 0b00001010, 0b10000000, 0b00000000, 0b00001010, 0b10000000,
-			0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000, 0b00001000, 0b10000000, 0b00000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000,
-			0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00001111, 0b10000000, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000,
+			0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000,
+			0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000,
+			0b00001010, 0b10000000, 0b00000000, 0b00001010, 0b10000000, 0b00000000, 0b00001010, 0b10000000, 0b00000000, 0b00001010, 0b10000000, 0b00000000, 0b00001010, 0b10000000, 0b00000000, 0b00001010, 0b10000000, 0b00000000, 0b00001010, 0b10000000,
+			0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000,
+			0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000,
 			0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
@@ -4836,11 +4836,11 @@ var baseList = []*bitmap.Image{
 			0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
-			0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00001111, 0b10000000, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000,
-			0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000, 0b00001000, 0b10000000, 0b00000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000,
-			0b00001010, 0b10000000, 0b00000000, 0b00001010, 0b10000000, 0b00001010, 0b10000000, 0b00000000, 0b00000000, 0b00001010, 0b10000000, 0b00000000, 0b00001010, 0b10000000, 0b00000000, 0b00001010, 0b10000000, 0b00000000, 0b00001010, 0b10000000,
-			0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000, 0b00001000, 0b10000000, 0b00000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000,
-			0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00001111, 0b10000000, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000,
+			0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000,
+			0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000,
+			0b00001010, 0b10000000, 0b00000000, 0b00001010, 0b10000000, 0b00000000, 0b00001010, 0b10000000, 0b00000000, 0b00001010, 0b10000000, 0b00000000, 0b00001010, 0b10000000, 0b00000000, 0b00001010, 0b10000000, 0b00000000, 0b00001010, 0b10000000,
+			0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000,
+			0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000,
 			0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
@@ -4860,11 +4860,11 @@ var baseList = []*bitmap.Image{
 			0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
-			0b00000010, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00001111, 0b10000000, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000,
-			0b00000000, 0b00000000, 0b00000000, 0b00001000, 0b10000000, 0b00001000, 0b10000000, 0b00000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000,
-			0b11111110, 0b00000000, 0b00000000, 0b00001010, 0b10000000, 0b00001010, 0b10000000, 0b00000000, 0b00000000, 0b00001010, 0b10000000, 0b00000000, 0b00001010, 0b10000000, 0b00000000, 0b00001010, 0b10000000, 0b00000000, 0b00001010, 0b10000000,
-			0b10000010, 0b00000000, 0b00000000, 0b00001000, 0b10000000, 0b00001000, 0b10000000, 0b00000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000,
-			0b10111010, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00001111, 0b10000000, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000,
+			0b00000010, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000,
+			0b00000000, 0b00000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000,
+			0b11111110, 0b00000000, 0b00000000, 0b00001010, 0b10000000, 0b00000000, 0b00001010, 0b10000000, 0b00000000, 0b00001010, 0b10000000, 0b00000000, 0b00001010, 0b10000000, 0b00000000, 0b00001010, 0b10000000, 0b00000000, 0b00001010, 0b10000000,
+			0b10000010, 0b00000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00001000, 0b10000000,
+			0b10111010, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000,
 			0b10111010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b10111010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b10000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
@@ -4881,11 +4881,11 @@ var baseList = []*bitmap.Image{
 			0b10000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00100000, 0b10000000,
 			0b10111010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00101110, 0b10000000,
 			0b10111010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00101110, 0b10000000,
-			0b10111010, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b00101110, 0b10000000,
-			0b10000010, 0b00000000, 0b00000010, 0b00100000, 0b00000000, 0b00000010, 0b00100000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00000010, 0b00100000, 0b00000000, 0b00000000, 0b00100000, 0b10000000,
+			0b10111010, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b00101110, 0b10000000,
+			0b10000010, 0b00000000, 0b00000010, 0b00100000, 0b00000000, 0b00000000, 0b10001000, 0b00000000, 0b00000000, 0b00100010, 0b00000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00000010, 0b00100000, 0b00000000, 0b00000000, 0b00100000, 0b10000000,
 			0b11111110, 0b10101010, 0b10101010, 0b10101010, 0b10101010, 0b10101010, 0b10101010, 0b10101010, 0b10101010, 0b10101010, 0b10101010, 0b10101010, 0b10101010, 0b10101010, 0b10101010, 0b10101010, 0b10101010, 0b10101010, 0b10101010, 0b10111111, 0b10000000,
-			0b00000000, 0b00000000, 0b00000010, 0b00100000, 0b00000000, 0b00000010, 0b00100000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00000010, 0b00100000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
-			0b00000010, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
+			0b00000000, 0b00000000, 0b00000010, 0b00100000, 0b00000000, 0b00000000, 0b10001000, 0b00000000, 0b00000000, 0b00100010, 0b00000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00000010, 0b00100000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
+			0b00000010, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
@@ -4899,11 +4899,11 @@ var baseList = []*bitmap.Image{
 			0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
-			0b00001111, 0b10000000, 0b00000011, 0b11100000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
-			0b00001000, 0b10000000, 0b00000010, 0b00100000, 0b00000000, 0b00000010, 0b00100000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00000010, 0b00100000, 0b00000000, 0b00000000, 0b10001000, 0b00000000,
-			0b00001010, 0b10000000, 0b00000010, 0b10100000, 0b00000000, 0b00000010, 0b10100000, 0b00000000, 0b00001010, 0b10000000, 0b00000000, 0b00000000, 0b00001010, 0b10000000, 0b00000000, 0b00000010, 0b10100000, 0b00000000, 0b00000000, 0b10101000, 0b00000000,
-			0b00001000, 0b10000000, 0b00000010, 0b00100000, 0b00000000, 0b00000010, 0b00100000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00000010, 0b00100000, 0b00000000, 0b00000000, 0b10001000, 0b00000000,
-			0b00001111, 0b10000000, 0b00000011, 0b11100000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
+			0b00001111, 0b10000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
+			0b00001000, 0b10000000, 0b00000010, 0b00100000, 0b00000000, 0b00000000, 0b10001000, 0b00000000, 0b00000000, 0b00100010, 0b00000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00000010, 0b00100000, 0b00000000, 0b00000000, 0b10001000, 0b00000000,
+			0b00001010, 0b10000000, 0b00000010, 0b10100000, 0b00000000, 0b00000000, 0b10101000, 0b00000000, 0b00000000, 0b00101010, 0b00000000, 0b00000000, 0b00001010, 0b10000000, 0b00000000, 0b00000010, 0b10100000, 0b00000000, 0b00000000, 0b10101000, 0b00000000,
+			0b00001000, 0b10000000, 0b00000010, 0b00100000, 0b00000000, 0b00000000, 0b10001000, 0b00000000, 0b00000000, 0b00100010, 0b00000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00000010, 0b00100000, 0b00000000, 0b00000000, 0b10001000, 0b00000000,
+			0b00001111, 0b10000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
 			0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
@@ -4923,15 +4923,13 @@ var baseList = []*bitmap.Image{
 			0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
-			0b00001111, 0b10000000, 0b00000011, 0b11100000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
-			0b00001000, 0b10000000, 0b00000010, 0b00100000, 0b00000000, 0b00000010, 0b00100000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00000010, 0b00100000, 0b00000000, 0b00000000, 0b10001000, 0b00000000,
-			0b00001010, 0b10000000, 0b00000010, 0b10100000, 0b00000000, 0b00000010, 0b10100000, 0b00000000, 0b00001010, 0b10000000, 0b00000000, 0b00000000, 0b00001010, 0b10000000, 0b00000000, 0b00000010, 0b10100000, 0b00000000, 0b00000000, 0b10101000, 0b00000000,
-			0b00001000, 0b10000000, 0b00000010, 0b00100000, 0b00000000, 0b00000010, 0b00100000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00000010, 0b00100000, 0b00000000, 0b00000000, 0b10001000, 0b00000000,
-			0b00001111, 0b10000000, 0b00000011, 0b11100000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
-			0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
-			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
+			0b00001111, 0b10000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
+			0b00001000, 0b10000000, 0b00000010, 0b00100000, 0b00000000, 0b00000000, 0b10001000, 0b00000000, 0b00000000, 0b00100010, 0b00000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00000010, 0b00100000, 0b00000000, 0b00000000, 0b10001000, 0b00000000,
+			0b00001010, 0b10000000, 0b00000010, 0b10100000, 0b00000000, 0b00000000, 0b10101000, 0b00000000, 0b00000000, 0b00101010, 0b00000000, 0b00000000, 0b00001010, 0b10000000, 0b00000000, 0b00000010, 0b10100000, 0b00000000, 0b00000000, 0b10101000, 0b00000000,
+			0b00001000, 0b10000000, 0b00000010, 0b00100000, 0b00000000, 0b00000000, 0b10001000, 0b00000000, 0b00000000, 0b00100010, 0b00000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00000010, 0b00100000, 0b00000000, 0b00000000, 0b10001000, 0b00000000,
+			0b00001111, 0b10000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
 			0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
@@ -4945,11 +4943,7 @@ var baseList = []*bitmap.Image{
 			0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
-			0b00001111, 0b10000000, 0b00000011, 0b11100000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
-			0b00001000, 0b10000000, 0b00000010, 0b00100000, 0b00000000, 0b00000010, 0b00100000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00000010, 0b00100000, 0b00000000, 0b00000000, 0b10001000, 0b00000000,
-			0b00001010, 0b10000000, 0b00000010, 0b10100000, 0b00000000, 0b00000010, 0b10100000, 0b00000000, 0b00001010, 0b10000000, 0b00000000, 0b00000000, 0b00001010, 0b10000000, 0b00000000, 0b00000010, 0b10100000, 0b00000000, 0b00000000, 0b10101000, 0b00000000,
-			0b00001000, 0b10000000, 0b00000010, 0b00100000, 0b00000000, 0b00000010, 0b00100000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00000010, 0b00100000, 0b00000000, 0b00000000, 0b10001000, 0b00000000,
-			0b00001111, 0b10000000, 0b00000011, 0b11100000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
+			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
@@ -4957,6 +4951,12 @@ var baseList = []*bitmap.Image{
 			0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
+			0b00001111, 0b10000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
+			0b00001000, 0b10000000, 0b00000010, 0b00100000, 0b00000000, 0b00000000, 0b10001000, 0b00000000, 0b00000000, 0b00100010, 0b00000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00000010, 0b00100000, 0b00000000, 0b00000000, 0b10001000, 0b00000000,
+			0b00001010, 0b10000000, 0b00000010, 0b10100000, 0b00000000, 0b00000000, 0b10101000, 0b00000000, 0b00000000, 0b00101010, 0b00000000, 0b00000000, 0b00001010, 0b10000000, 0b00000000, 0b00000010, 0b10100000, 0b00000000, 0b00000000, 0b10101000, 0b00000000,
+			0b00001000, 0b10000000, 0b00000010, 0b00100000, 0b00000000, 0b00000000, 0b10001000, 0b00000000, 0b00000000, 0b00100010, 0b00000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00000010, 0b00100000, 0b00000000, 0b00000000, 0b10001000, 0b00000000,
+			0b00001111, 0b10000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
+			0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
@@ -4977,11 +4977,11 @@ var baseList = []*bitmap.Image{
 			0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
-			0b00001111, 0b10000000, 0b00000011, 0b11100000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
-			0b00001000, 0b10000000, 0b00000010, 0b00100000, 0b00000000, 0b00000010, 0b00100000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00000010, 0b00100000, 0b00000000, 0b00000000, 0b10001000, 0b00000000,
-			0b00001010, 0b10000000, 0b00000010, 0b10100000, 0b00000000, 0b00000010, 0b10100000, 0b00000000, 0b00001010, 0b10000000, 0b00000000, 0b00000000, 0b00001010, 0b10000000, 0b00000000, 0b00000010, 0b10100000, 0b00000000, 0b00000000, 0b10101000, 0b00000000,
-			0b00001000, 0b10000000, 0b00000010, 0b00100000, 0b00000000, 0b00000010, 0b00100000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00000010, 0b00100000, 0b00000000, 0b00000000, 0b10001000, 0b00000000,
-			0b00001111, 0b10000000, 0b00000011, 0b11100000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
+			0b00001111, 0b10000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
+			0b00001000, 0b10000000, 0b00000010, 0b00100000, 0b00000000, 0b00000000, 0b10001000, 0b00000000, 0b00000000, 0b00100010, 0b00000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00000010, 0b00100000, 0b00000000, 0b00000000, 0b10001000, 0b00000000,
+			0b00001010, 0b10000000, 0b00000010, 0b10100000, 0b00000000, 0b00000000, 0b10101000, 0b00000000, 0b00000000, 0b00101010, 0b00000000, 0b00000000, 0b00001010, 0b10000000, 0b00000000, 0b00000010, 0b10100000, 0b00000000, 0b00000000, 0b10101000, 0b00000000,
+			0b00001000, 0b10000000, 0b00000010, 0b00100000, 0b00000000, 0b00000000, 0b10001000, 0b00000000, 0b00000000, 0b00100010, 0b00000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00000010, 0b00100000, 0b00000000, 0b00000000, 0b10001000, 0b00000000,
+			0b00001111, 0b10000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
 			0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
@@ -5003,11 +5003,11 @@ var baseList = []*bitmap.Image{
 			0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
-			0b00001111, 0b10000000, 0b00000011, 0b11100000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
-			0b00001000, 0b10000000, 0b00000010, 0b00100000, 0b00000000, 0b00000010, 0b00100000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00000010, 0b00100000, 0b00000000, 0b00000000, 0b10001000, 0b00000000,
-			0b00001010, 0b10000000, 0b00000010, 0b10100000, 0b00000000, 0b00000010, 0b10100000, 0b00000000, 0b00001010, 0b10000000, 0b00000000, 0b00000000, 0b00001010, 0b10000000, 0b00000000, 0b00000010, 0b10100000, 0b00000000, 0b00000000, 0b10101000, 0b00000000,
-			0b00001000, 0b10000000, 0b00000010, 0b00100000, 0b00000000, 0b00000010, 0b00100000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00000010, 0b00100000, 0b00000000, 0b00000000, 0b10001000, 0b00000000,
-			0b00001111, 0b10000000, 0b00000011, 0b11100000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
+			0b00001111, 0b10000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
+			0b00001000, 0b10000000, 0b00000010, 0b00100000, 0b00000000, 0b00000000, 0b10001000, 0b00000000, 0b00000000, 0b00100010, 0b00000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00000010, 0b00100000, 0b00000000, 0b00000000, 0b10001000, 0b00000000,
+			0b00001010, 0b10000000, 0b00000010, 0b10100000, 0b00000000, 0b00000000, 0b10101000, 0b00000000, 0b00000000, 0b00101010, 0b00000000, 0b00000000, 0b00001010, 0b10000000, 0b00000000, 0b00000010, 0b10100000, 0b00000000, 0b00000000, 0b10101000, 0b00000000,
+			0b00001000, 0b10000000, 0b00000010, 0b00100000, 0b00000000, 0b00000000, 0b10001000, 0b00000000, 0b00000000, 0b00100010, 0b00000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00000010, 0b00100000, 0b00000000, 0b00000000, 0b10001000, 0b00000000,
+			0b00001111, 0b10000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
 			0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
@@ -5029,11 +5029,11 @@ var baseList = []*bitmap.Image{
 			0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
-			0b00000010, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
-			0b00000000, 0b00000000, 0b00000010, 0b00100000, 0b00000000, 0b00000010, 0b00100000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00000010, 0b00100000, 0b00000000, 0b00000000, 0b10001000, 0b00000000,
-			0b11111110, 0b00000000, 0b00000010, 0b10100000, 0b00000000, 0b00000010, 0b10100000, 0b00000000, 0b00001010, 0b10000000, 0b00000000, 0b00000000, 0b00001010, 0b10000000, 0b00000000, 0b00000010, 0b10100000, 0b00000000, 0b00000000, 0b10101000, 0b00000000,
-			0b10000010, 0b00000000, 0b00000010, 0b00100000, 0b00000000, 0b00000010, 0b00100000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00000010, 0b00100000, 0b00000000, 0b00000000, 0b10001000, 0b00000000,
-			0b10111010, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
+			0b00000010, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
+			0b00000000, 0b00000000, 0b00000010, 0b00100000, 0b00000000, 0b00000000, 0b10001000, 0b00000000, 0b00000000, 0b00100010, 0b00000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00000010, 0b00100000, 0b00000000, 0b00000000, 0b10001000, 0b00000000,
+			0b11111110, 0b00000000, 0b00000010, 0b10100000, 0b00000000, 0b00000000, 0b10101000, 0b00000000, 0b00000000, 0b00101010, 0b00000000, 0b00000000, 0b00001010, 0b10000000, 0b00000000, 0b00000010, 0b10100000, 0b00000000, 0b00000000, 0b10101000, 0b00000000,
+			0b10000010, 0b00000000, 0b00000010, 0b00100000, 0b00000000, 0b00000000, 0b10001000, 0b00000000, 0b00000000, 0b00100010, 0b00000000, 0b00000000, 0b00001000, 0b10000000, 0b00000000, 0b00000010, 0b00100000, 0b00000000, 0b00000000, 0b10001000, 0b00000000,
+			0b10111010, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
 			0b10111010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b10111010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b10000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
@@ -8235,11 +8235,11 @@ var usedList = []*bitmap.Image{
 			0b11111111, 0b10000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000011, 0b11111111, 0b10000000,
 			0b11111111, 0b10000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000011, 0b11111111, 0b10000000,
 			0b11111111, 0b10000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000011, 0b11111111, 0b10000000,
-			0b11111111, 0b10000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00011111, 0b00000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000011, 0b11111111, 0b10000000,
-			0b11111111, 0b10000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00011111, 0b00000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000011, 0b11111111, 0b10000000,
+			0b11111111, 0b10000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000011, 0b11111111, 0b10000000,
+			0b11111111, 0b10000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000011, 0b11111111, 0b10000000,
 			0b11111111, 0b11111111, 0b11111111, 0b11111111, 0b11111111, 0b11111111, 0b11111111, 0b11111111, 0b11111111, 0b11111111, 0b11111111, 0b11111111, 0b11111111, 0b11111111, 0b11111111, 0b11111111, 0b11111111, 0b10000000,
-			0b11111111, 0b10000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00011111, 0b00000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b01111111, 0b10000000,
-			0b11111111, 0b10000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00011111, 0b00000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b01111111, 0b10000000,
+			0b11111111, 0b10000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b01111111, 0b10000000,
+			0b11111111, 0b10000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b01111111, 0b10000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
@@ -8255,11 +8255,11 @@ var usedList = []*bitmap.Image{
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
-			0b00001111, 0b10000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00011111, 0b00000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
-			0b00001111, 0b10000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00011111, 0b00000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
-			0b00001111, 0b10000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00011111, 0b00000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
-			0b00001111, 0b10000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00011111, 0b00000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
-			0b00001111, 0b10000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00011111, 0b00000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
+			0b00001111, 0b10000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
+			0b00001111, 0b10000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
+			0b00001111, 0b10000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
+			0b00001111, 0b10000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
+			0b00001111, 0b10000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
@@ -8281,11 +8281,11 @@ var usedList = []*bitmap.Image{
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
-			0b00001111, 0b10000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00011111, 0b00000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
-			0b00001111, 0b10000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00011111, 0b00000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
-			0b00001111, 0b10000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00011111, 0b00000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
-			0b00001111, 0b10000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00011111, 0b00000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
-			0b00001111, 0b10000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00011111, 0b00000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
+			0b00001111, 0b10000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
+			0b00001111, 0b10000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
+			0b00001111, 0b10000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
+			0b00001111, 0b10000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
+			0b00001111, 0b10000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
@@ -8306,12 +8306,12 @@ var usedList = []*bitmap.Image{
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
-			0b00001111, 0b10000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00011111, 0b00000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
-			0b00001111, 0b10000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00011111, 0b00000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
-			0b00001111, 0b10000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00011111, 0b00000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
-			0b00001111, 0b10000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00011111, 0b00000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
-			0b00001111, 0b10000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00011111, 0b00000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
+			0b00001111, 0b10000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
+			0b00001111, 0b10000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
+			0b00001111, 0b10000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
+			0b00001111, 0b10000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
+			0b00001111, 0b10000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
@@ -8333,11 +8333,11 @@ var usedList = []*bitmap.Image{
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
-			0b00001111, 0b10000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00011111, 0b00000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
-			0b00001111, 0b10000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00011111, 0b00000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
-			0b00001111, 0b10000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00011111, 0b00000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
-			0b00001111, 0b10000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00011111, 0b00000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
-			0b00001111, 0b10000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00011111, 0b00000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
+			0b00001111, 0b10000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
+			0b00001111, 0b10000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
+			0b00001111, 0b10000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
+			0b00001111, 0b10000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
+			0b00001111, 0b10000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
@@ -8359,11 +8359,11 @@ var usedList = []*bitmap.Image{
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b11111110, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b11111110, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
-			0b11111110, 0b00000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00011111, 0b00000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
-			0b11111111, 0b10000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00011111, 0b00000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
-			0b11111111, 0b10000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00011111, 0b00000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
-			0b11111111, 0b10000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00011111, 0b00000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
-			0b11111111, 0b10000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00011111, 0b00000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
+			0b11111110, 0b00000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
+			0b11111111, 0b10000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
+			0b11111111, 0b10000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
+			0b11111111, 0b10000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
+			0b11111111, 0b10000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
 			0b11111111, 0b10000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b11111111, 0b10000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b11111111, 0b10000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
@@ -9000,11 +9000,11 @@ var usedList = []*bitmap.Image{
 			0b11111111, 0b10000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00111111, 0b11111000,
 			0b11111111, 0b10000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00111111, 0b11111000,
 			0b11111111, 0b10000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00111111, 0b11111000,
-			0b11111111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00001111, 0b10000000, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00111111, 0b11111000,
-			0b11111111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00001111, 0b10000000, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00111111, 0b11111000,
+			0b11111111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00111111, 0b11111000,
+			0b11111111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00111111, 0b11111000,
 			0b11111111, 0b11111111, 0b11111111, 0b11111111, 0b11111111, 0b11111111, 0b11111111, 0b11111111, 0b11111111, 0b11111111, 0b11111111, 0b11111111, 0b11111111, 0b11111111, 0b11111111, 0b11111111, 0b11111111, 0b11111111, 0b11111111, 0b11111000,
-			0b11111111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00001111, 0b10000000, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000111, 0b11111000,
-			0b11111111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00001111, 0b10000000, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000111, 0b11111000,
+			0b11111111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000111, 0b11111000,
+			0b11111111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000111, 0b11111000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
@@ -9024,11 +9024,11 @@ var usedList = []*bitmap.Image{
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
-			0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00001111, 0b10000000, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000,
-			0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00001111, 0b10000000, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000,
-			0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00001111, 0b10000000, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000,
-			0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00001111, 0b10000000, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000,
-			0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00001111, 0b10000000, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000,
+			0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000,
+			0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000,
+			0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000,
+			0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000,
+			0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
@@ -9040,11 +9040,6 @@ var usedList = []*bitmap.Image{
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
-			0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00001111, 0b10000000, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000,
-			0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00001111, 0b10000000, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000,
-			0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00001111, 0b10000000, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000,
-			0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00001111, 0b10000000, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000,
-			0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00001111, 0b10000000, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
@@ -9053,6 +9048,11 @@ var usedList = []*bitmap.Image{
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
+			0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000,
+			0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000,
+			0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000,
+			0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000,
+			0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
@@ -9072,11 +9072,11 @@ var usedList = []*bitmap.Image{
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
-			0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00001111, 0b10000000, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000,
-			0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00001111, 0b10000000, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000,
-			0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00001111, 0b10000000, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000,
-			0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00001111, 0b10000000, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000,
-			0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00001111, 0b10000000, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000,
+			0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000,
+			0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000,
+			0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000,
+			0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000,
+			0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
@@ -9096,11 +9096,11 @@ var usedList = []*bitmap.Image{
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
-			0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00001111, 0b10000000, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000,
-			0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00001111, 0b10000000, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000,
-			0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00001111, 0b10000000, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000,
-			0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00001111, 0b10000000, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000,
-			0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00001111, 0b10000000, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000,
+			0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000,
+			0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000,
+			0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000,
+			0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000,
+			0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
@@ -9120,11 +9120,11 @@ var usedList = []*bitmap.Image{
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
-			0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00001111, 0b10000000, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000,
-			0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00001111, 0b10000000, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000,
-			0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00001111, 0b10000000, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000,
-			0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00001111, 0b10000000, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000,
-			0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00001111, 0b10000000, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000,
+			0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000,
+			0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000,
+			0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000,
+			0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000,
+			0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
@@ -9144,11 +9144,11 @@ var usedList = []*bitmap.Image{
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b11111110, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b11111110, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
-			0b11111110, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00001111, 0b10000000, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000,
-			0b11111111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00001111, 0b10000000, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000,
-			0b11111111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00001111, 0b10000000, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000,
-			0b11111111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00001111, 0b10000000, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000,
-			0b11111111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00001111, 0b10000000, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000,
+			0b11111110, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000,
+			0b11111111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000,
+			0b11111111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000,
+			0b11111111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000,
+			0b11111111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00001111, 0b10000000,
 			0b11111111, 0b10000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b11111111, 0b10000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b11111111, 0b10000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
@@ -9165,11 +9165,11 @@ var usedList = []*bitmap.Image{
 			0b11111111, 0b10000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000011, 0b11111111, 0b10000000,
 			0b11111111, 0b10000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000011, 0b11111111, 0b10000000,
 			0b11111111, 0b10000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000011, 0b11111111, 0b10000000,
-			0b11111111, 0b10000000, 0b00000011, 0b11100000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000011, 0b11111111, 0b10000000,
-			0b11111111, 0b10000000, 0b00000011, 0b11100000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000011, 0b11111111, 0b10000000,
+			0b11111111, 0b10000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000011, 0b11111111, 0b10000000,
+			0b11111111, 0b10000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000011, 0b11111111, 0b10000000,
 			0b11111111, 0b11111111, 0b11111111, 0b11111111, 0b11111111, 0b11111111, 0b11111111, 0b11111111, 0b11111111, 0b11111111, 0b11111111, 0b11111111, 0b11111111, 0b11111111, 0b11111111, 0b11111111, 0b11111111, 0b11111111, 0b11111111, 0b11111111, 0b10000000,
-			0b11111111, 0b10000000, 0b00000011, 0b11100000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b01111111, 0b10000000,
-			0b11111111, 0b10000000, 0b00000011, 0b11100000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b01111111, 0b10000000,
+			0b11111111, 0b10000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b01111111, 0b10000000,
+			0b11111111, 0b10000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b01111111, 0b10000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
@@ -9183,11 +9183,11 @@ var usedList = []*bitmap.Image{
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
-			0b00001111, 0b10000000, 0b00000011, 0b11100000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
-			0b00001111, 0b10000000, 0b00000011, 0b11100000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
-			0b00001111, 0b10000000, 0b00000011, 0b11100000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
-			0b00001111, 0b10000000, 0b00000011, 0b11100000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
-			0b00001111, 0b10000000, 0b00000011, 0b11100000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
+			0b00001111, 0b10000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
+			0b00001111, 0b10000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
+			0b00001111, 0b10000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
+			0b00001111, 0b10000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
+			0b00001111, 0b10000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
@@ -9207,13 +9207,13 @@ var usedList = []*bitmap.Image{
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
-			0b00001111, 0b10000000, 0b00000011, 0b11100000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
-			0b00001111, 0b10000000, 0b00000011, 0b11100000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
-			0b00001111, 0b10000000, 0b00000011, 0b11100000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
-			0b00001111, 0b10000000, 0b00000011, 0b11100000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
-			0b00001111, 0b10000000, 0b00000011, 0b11100000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
+			0b00001111, 0b10000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
+			0b00001111, 0b10000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
+			0b00001111, 0b10000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
+			0b00001111, 0b10000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
+			0b00001111, 0b10000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
@@ -9229,17 +9229,17 @@ var usedList = []*bitmap.Image{
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
-			0b00001111, 0b10000000, 0b00000011, 0b11100000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
-			0b00001111, 0b10000000, 0b00000011, 0b11100000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
-			0b00001111, 0b10000000, 0b00000011, 0b11100000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
-			0b00001111, 0b10000000, 0b00000011, 0b11100000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
-			0b00001111, 0b10000000, 0b00000011, 0b11100000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
+			0b00001111, 0b10000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
+			0b00001111, 0b10000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
+			0b00001111, 0b10000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
+			0b00001111, 0b10000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
+			0b00001111, 0b10000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
@@ -9261,11 +9261,11 @@ var usedList = []*bitmap.Image{
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
-			0b00001111, 0b10000000, 0b00000011, 0b11100000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
-			0b00001111, 0b10000000, 0b00000011, 0b11100000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
-			0b00001111, 0b10000000, 0b00000011, 0b11100000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
-			0b00001111, 0b10000000, 0b00000011, 0b11100000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
-			0b00001111, 0b10000000, 0b00000011, 0b11100000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
+			0b00001111, 0b10000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
+			0b00001111, 0b10000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
+			0b00001111, 0b10000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
+			0b00001111, 0b10000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
+			0b00001111, 0b10000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
@@ -9287,11 +9287,11 @@ var usedList = []*bitmap.Image{
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
-			0b00001111, 0b10000000, 0b00000011, 0b11100000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
-			0b00001111, 0b10000000, 0b00000011, 0b11100000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
-			0b00001111, 0b10000000, 0b00000011, 0b11100000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
-			0b00001111, 0b10000000, 0b00000011, 0b11100000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
-			0b00001111, 0b10000000, 0b00000011, 0b11100000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
+			0b00001111, 0b10000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
+			0b00001111, 0b10000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
+			0b00001111, 0b10000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
+			0b00001111, 0b10000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
+			0b00001111, 0b10000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
@@ -9313,11 +9313,11 @@ var usedList = []*bitmap.Image{
 			0b00000010, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b11111110, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b11111110, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
-			0b11111110, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
-			0b11111111, 0b10000000, 0b00000011, 0b11100000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
-			0b11111111, 0b10000000, 0b00000011, 0b11100000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
-			0b11111111, 0b10000000, 0b00000011, 0b11100000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
-			0b11111111, 0b10000000, 0b00000011, 0b11100000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
+			0b11111110, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
+			0b11111111, 0b10000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
+			0b11111111, 0b10000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
+			0b11111111, 0b10000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
+			0b11111111, 0b10000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000, 0b00000000, 0b00111110, 0b00000000, 0b00000000, 0b00001111, 0b10000000, 0b00000000, 0b00000011, 0b11100000, 0b00000000, 0b00000000, 0b11111000, 0b00000000,
 			0b11111111, 0b10000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b11111111, 0b10000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
 			0b11111111, 0b10000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000, 0b00000000,
@@ -10041,3 +10041,49 @@ var usedList = []*bitmap.Image{
 		},
 	},
 }
+
+// alignmentPatternPositions is the list of the center coordinates of alignment patterns.
+// The patterns are placed at all combinations of them except the ones overlapping finder patterns.
+var alignmentPatternPositions = [][]int{
+	nil,                            // dummy
+	{},                             // Version 1
+	{6, 18},                        // Version 2
+	{6, 22},                        // Version 3
+	{6, 26},                        // Version 4
+	{6, 30},                        // Version 5
+	{6, 34},                        // Version 6
+	{6, 22, 38},                    // Version 7
+	{6, 24, 42},                    // Version 8
+	{6, 26, 46},                    // Version 9
+	{6, 28, 50},                    // Version 10
+	{6, 30, 54},                    // Version 11
+	{6, 32, 58},                    // Version 12
+	{6, 34, 62},                    // Version 13
+	{6, 26, 46, 66},                // Version 14
+	{6, 26, 48, 70},                // Version 15
+	{6, 26, 50, 74},                // Version 16
+	{6, 30, 54, 78},                // Version 17
+	{6, 30, 56, 82},                // Version 18
+	{6, 30, 58, 86},                // Version 19
+	{6, 34, 62, 90},                // Version 20
+	{6, 28, 50, 72, 94},            // Version 21
+	{6, 26, 50, 74, 98},            // Version 22
+	{6, 30, 54, 78, 102},           // Version 23
+	{6, 28, 54, 80, 106},           // Version 24
+	{6, 32, 58, 84, 110},           // Version 25
+	{6, 30, 58, 86, 114},           // Version 26
+	{6, 34, 62, 90, 118},           // Version 27
+	{6, 26, 50, 74, 98, 122},       // Version 28
+	{6, 30, 54, 78, 102, 126},      // Version 29
+	{6, 26, 52, 78, 104, 130},      // Version 30
+	{6, 30, 56, 82, 108, 134},      // Version 31
+	{6, 34, 60, 86, 112, 138},      // Version 32
+	{6, 30, 58, 86, 114, 142},      // Version 33
+	{6, 34, 62, 90, 118, 146},      // Version 34
+	{6, 30, 54, 78, 102, 126, 150}, // Version 35
+	{6, 24, 50, 76, 102, 128, 154}, // Version 36
+	{6, 28, 54, 80, 106, 132, 158}, // Version 37
+	{6, 32, 58, 84, 110, 136, 162}, // Version 38
+	{6, 26, 54, 82, 110, 138, 166}, // Version 39
+	{6, 30, 58, 86, 114, 142, 170}, // Version 40
+}
@@ -0,0 +1,74 @@
+package qrcode
+
+import (
+	"testing"
+
+	internalbitmap "github.com/shogo82148/qrcode/internal/bitmap"
+	"github.com/shogo82148/qrcode/internal/bitstream"
+)
+
+func TestCodewordMap(t *testing.T) {
+	for version := Version(1); version <= 40; version++ {
+		for _, level := range []Level{LevelL, LevelM, LevelQ, LevelH} {
+			m, err := CodewordMap(version, level)
+			if err != nil {
+				t.Fatal(err)
+			}
+			capacity := capacityTable[version][level]
+			if len(m) != capacity.Total*8 {
+				t.Errorf("%d-%s: got %d bits, want %d", version, level, len(m), capacity.Total*8)
+				continue
+			}
+			seen := map[[3]int]bool{}
+			for _, p := range m {
+				key := [3]int{p.Block, p.Index, p.Bit}
+				if seen[key] {
+					t.Errorf("%d-%s: duplicated bit: %v", version, level, key)
+				}
+				seen[key] = true
+			}
+		}
+	}
+}
+
+func TestCodewordMap_Encode(t *testing.T) {
+	qr, err := New([]byte("Hello, world! 123456789012345678901234567890"), WithLevel(LevelQ))
+	if err != nil {
+		t.Fatal(err)
+	}
+	qr.Mask = Mask0
+
+	var buf bitstream.Buffer
+	if err := qr.encodeToBits(&buf); err != nil {
+		t.Fatal(err)
+	}
+	stream := buf.Bytes()
+
+	img, err := qr.EncodeToBitmap()
+	if err != nil {
+		t.Fatal(err)
+	}
+	binimg := internalbitmap.Import(img)
+	binimg.Mask(binimg, usedList[qr.Version], maskList[Mask0])
+
+	m, err := CodewordMap(qr.Version, qr.Level)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, p := range m {
+		got := bool(binimg.BinaryAt(p.X, p.Y))
+		want := (stream[p.Codeword]>>p.Bit)&1 != 0
+		if got != want {
+			t.Errorf("module (%d, %d): got %t, want %t", p.X, p.Y, got, want)
+		}
+	}
+}
+
+func TestCodewordMap_Invalid(t *testing.T) {
+	if _, err := CodewordMap(0, LevelL); err == nil {
+		t.Error("want error, got nil")
+	}
+	if _, err := CodewordMap(1, Level(-1)); err == nil {
+		t.Error("want error, got nil")
+	}
+}
@@ -12,110 +12,154 @@ import (
 	"github.com/shogo82148/qrcode/internal/reedsolomon"
 )
 
-func DecodeBitmap(img *bitmap.Image) (*QRCode, error) {
-	bounds := img.Bounds()
-	version := Version((bounds.Dx() - 17) / 4)
+// DecodeBitmap decodes the QR Code in img, whose module size is one pixel.
+// img may be modified during decoding.
+// The mirrored, inverted and rotated symbols are also decoded, and they are reported in [QRCode.Orientation].
+// The rules checked on the symbol depend on the decode mode set by [WithDecodeMode].
+func DecodeBitmap(img *bitmap.Image, opts ...DecodeOptions) (*QRCode, error) {
+	var report Report
+	return decodeBitmap(img, &report, newDecodeOptions(opts))
+}
+
+func decodeBitmap(img *bitmap.Image, report *Report, opts decodeOptions) (*QRCode, error) {
 	binimg := internalbitmap.Import(img)
+	orientation := detectOrientation(binimg)
+	binimg = orientation.normalize(binimg)
+	if detectMirrored(binimg) {
+		orientation.Mirrored = true
+		binimg = binimg.Transpose()
+	}
+	report.Orientation = orientation
+
+	qr, err := decodeSymbol(binimg, report, opts)
+	if err != nil {
+		return nil, err
+	}
+	qr.Orientation = orientation
+	return qr, nil
+}
+
+// decodeSymbol decodes the symbol in the normal orientation.
+func decodeSymbol(binimg *internalbitmap.Image, report *Report, opts decodeOptions) (*QRCode, error) {
+	bounds := binimg.Rect
+	version := Version((bounds.Dx() - 17) / 4)
+	report.Version = version
 
 	level, mask, err := decodeFormat(binimg)
 	if err != nil {
 		return nil, err
 	}
-	w := 16 + 4*int(version)
+	report.Level = level
+	report.Mask = mask
+	format := encodedFormat[int(level)<<3+int(mask)]
+	raw1, raw2 := readFormat(binimg)
+	report.FormatDistances = [2]int{bits.OnesCount(raw1 ^ format), bits.OnesCount(raw2 ^ format)}
+	if version >= 7 {
+		raw1, raw2 := readVersion(binimg)
+		encoded := encodedVersion[version]
+		report.VersionDistances = [2]int{bits.OnesCount(raw1 ^ encoded), bits.OnesCount(raw2 ^ encoded)}
+	}
+	if report.FormatDistances != [2]int{} || report.VersionDistances != [2]int{} {
+		report.violate(ViolationFormat)
+	}
 
 	// mask
 	used := usedList[version]
 	binimg.Mask(binimg, used, maskList[mask])
 
 	var buf bitstream.Buffer
-	dy := -1
-	x, y := w, w
-	for {
-		if x == timingPatternOffset {
-			// skip timing pattern
-			x--
-			continue
-		}
-		if !used.BinaryAt(x, y) {
-			if binimg.BinaryAt(x, y) {
-				buf.WriteBit(1)
-			} else {
-				buf.WriteBit(0)
-			}
-		}
-		x--
-		if x < 0 {
-			break
-		}
-
-		if !used.BinaryAt(x, y) {
-			if binimg.BinaryAt(x, y) {
-				buf.WriteBit(1)
-			} else {
-				buf.WriteBit(0)
-			}
-		}
-		x, y = x+1, y+dy
-		if y < 0 || y > w {
-			dy *= -1
-			x, y = x-2, y+dy
-		}
-		if x < 0 {
-			break
+	for _, pt := range placement(version) {
+		if binimg.BinaryAt(pt.X, pt.Y) {
+			buf.WriteBit(1)
+		} else {
+			buf.WriteBit(0)
 		}
 	}
 
 	// un-interleave
+	capacity := capacityTable[version][level]
+	report.Codewords = append([]byte(nil), buf.Bytes()[:capacity.Total]...)
 	blocks := decodeFromBits(version, level, buf.Bytes())
 
 	// error correction
 	var result []byte
-	for _, blk := range blocks {
+	report.Blocks = make([]BlockReport, len(blocks))
+	for i, blk := range blocks {
 		data := append(blk.data, blk.correction...)
-		if err := reedsolomon.Decode(data, 2); err != nil {
+		orig := append([]byte(nil), data...)
+		report.Blocks[i] = BlockReport{
+			DataCodewords:            len(blk.data),
+			ErrorCorrectionCodewords: len(blk.correction),
+			Errors:                   -1,
+		}
+		if err := reedsolomon.Decode(data, len(blk.correction)); err != nil {
 			return nil, err
 		}
+		report.Blocks[i].Errors = countErrors(orig, data)
 		result = append(result, data[:len(blk.data)]...)
 	}
+	report.Data = result
 
 	// decode segments
 	stream := bitstream.NewBuffer(result)
+	r := newSegmentReader(stream, capacity.Data*8, opts.Mode, report)
 	segments := make([]Segment, 0)
+	terminator := -1
 LOOP:
-	for {
+	for !r.Truncated {
+		offset := stream.Offset()
+		r.End = offset
 		mode, err := stream.ReadBits(4)
 		if err == io.EOF {
 			break
 		}
 		switch Mode(mode) {
 		case ModeNumeric:
-			seg, err := decodeNumber(version, stream)
+			seg, err := decodeNumber(version, r)
 			if err != nil {
 				return nil, err
 			}
 			segments = append(segments, seg)
 		case ModeAlphanumeric:
-			seg, err := decodeAlphanumeric(version, stream)
+			seg, err := decodeAlphanumeric(version, r)
 			if err != nil {
 				return nil, err
 			}
 			segments = append(segments, seg)
 		case ModeBytes:
-			seg, err := decodeBytes(version, stream)
+			seg, err := decodeBytes(version, r)
 			if err != nil {
 				return nil, err
 			}
 			segments = append(segments, seg)
 		case ModeKanji:
-			seg, err := decodeKanji(version, stream)
+			seg, err := decodeKanji(version, r)
 			if err != nil {
 				return nil, err
 			}
 			segments = append(segments, seg)
 		case ModeTerminated:
+			terminator = offset
 			break LOOP
+		default:
+			r.UnknownMode = true
+			if opts.Mode != DecodeModeDefault {
+				// the rest of the stream can't be decoded.
+				break LOOP
+			}
 		}
 	}
+	if r.Truncated {
+		// the truncated segment takes the rest of the stream.
+		r.End = r.DataBits
+	}
+	if r.UnknownMode {
+		report.violate(ViolationMode)
+	}
+	report.checkPadding(terminator, 4, r.End, capacity.Data*8)
+	if opts.Mode == DecodeModeStrict && len(report.Violations) > 0 {
+		return nil, &ViolationError{Violations: report.Violations}
+	}
 
 	return &QRCode{
 		Version:  version,
@@ -126,9 +170,24 @@ LOOP:
 }
 
 func decodeFormat(img *internalbitmap.Image) (Level, Mask, error) {
+	rawFormat1, rawFormat2 := readFormat(img)
+	level, mask, ok := decodeFormat0(rawFormat1)
+	if ok {
+		return level, mask, nil
+	}
+
+	level, mask, ok = decodeFormat0(rawFormat2)
+	if ok {
+		return level, mask, nil
+	}
+
+	return 0, 0, errors.New("qrcode: QRCode not found")
+}
+
+// readFormat reads the two copies of the format information.
+func readFormat(img *internalbitmap.Image) (uint, uint) {
 	w := img.Rect.Dx() - 1
 
-	// decode format
 	var rawFormat1, rawFormat2 uint
 	for i := 0; i < 8; i++ {
 		if img.BinaryAt(8, skipTimingPattern(i)) {
@@ -141,21 +200,28 @@ func decodeFormat(img *internalbitmap.Image) (Level, Mask, error) {
 		if img.BinaryAt(w-i, 8) {
 			rawFormat2 |= 1 << i
 		}
-		if img.BinaryAt(8, w-i) {
+		// (8, w-7) is the dark module.
+		if i < 7 && img.BinaryAt(8, w-i) {
 			rawFormat2 |= 1 << (14 - i)
 		}
 	}
-	level, mask, ok := decodeFormat0(rawFormat1)
-	if ok {
-		return level, mask, nil
-	}
+	return rawFormat1, rawFormat2
+}
 
-	level, mask, ok = decodeFormat0(rawFormat2)
-	if ok {
-		return level, mask, nil
-	}
+// readVersion reads the two copies of the version information.
+func readVersion(img *internalbitmap.Image) (uint, uint) {
+	w := img.Rect.Dx() - 1
 
-	return 0, 0, errors.New("qrcode: QRCode not found")
+	var rawVersion1, rawVersion2 uint
+	for i := 0; i < 18; i++ {
+		if img.BinaryAt(i/3, w-10+i%3) {
+			rawVersion1 |= 1 << i
+		}
+		if img.BinaryAt(w-10+i%3, i/3) {
+			rawVersion2 |= 1 << i
+		}
+	}
+	return rawVersion1, rawVersion2
 }
 
 func decodeFormat0(raw uint) (Level, Mask, bool) {
@@ -213,7 +279,7 @@ func decodeFromBits(version Version, level Level, buf []byte) []block {
 	return blocks
 }
 
-func decodeNumber(version Version, buf *bitstream.Buffer) (Segment, error) {
+func decodeNumber(version Version, r *bitstream.SegmentReader) (Segment, error) {
 	var n int
 	switch {
 	case version <= 0 || version > 40:
@@ -225,12 +291,12 @@ func decodeNumber(version Version, buf *bitstream.Buffer) (Segment, error) {
 	default:
 		n = 14
 	}
-	length, err := buf.ReadBits(n)
+	length, err := r.Length(n, bitstream.NumericBits)
 	if err != nil {
 		return Segment{}, err
 	}
 	data := make([]byte, length)
-	if err := bitstream.DecodeNumeric(buf, data); err != nil {
+	if err := bitstream.DecodeNumeric(r.Buf, data); err != nil {
 		return Segment{}, err
 	}
 
@@ -240,7 +306,7 @@ func decodeNumber(version Version, buf *bitstream.Buffer) (Segment, error) {
 	}, nil
 }
 
-func decodeAlphanumeric(version Version, buf *bitstream.Buffer) (Segment, error) {
+func decodeAlphanumeric(version Version, r *bitstream.SegmentReader) (Segment, error) {
 	var n int
 	switch {
 	case version <= 0 || version > 40:
@@ -253,12 +319,12 @@ func decodeAlphanumeric(version Version, buf *bitstream.Buffer) (Segment, error)
 		n = 13
 	}
 
-	length, err := buf.ReadBits(n)
+	length, err := r.Length(n, bitstream.AlphanumericBits)
 	if err != nil {
 		return Segment{}, err
 	}
 	data := make([]byte, length)
-	if err := bitstream.DecodeAlphanumeric(buf, data); err != nil {
+	if err := bitstream.DecodeAlphanumeric(r.Buf, data); err != nil {
 		return Segment{}, err
 	}
 
@@ -268,7 +334,7 @@ func decodeAlphanumeric(version Version, buf *bitstream.Buffer) (Segment, error)
 	}, nil
 }
 
-func decodeBytes(version Version, buf *bitstream.Buffer) (Segment, error) {
+func decodeBytes(version Version, r *bitstream.SegmentReader) (Segment, error) {
 	var n int
 	switch {
 	case version <= 0 || version > 40:
@@ -278,12 +344,12 @@ func decodeBytes(version Version, buf *bitstream.Buffer) (Segment, error) {
 	default:
 		n = 16
 	}
-	length, err := buf.ReadBits(n)
+	length, err := r.Length(n, bitstream.BytesBits)
 	if err != nil {
 		return Segment{}, err
 	}
 	data := make([]byte, length)
-	if err := bitstream.DecodeBytes(buf, data); err != nil {
+	if err := bitstream.DecodeBytes(r.Buf, data); err != nil {
 		return Segment{}, err
 	}
 
@@ -293,7 +359,7 @@ func decodeBytes(version Version, buf *bitstream.Buffer) (Segment, error) {
 	}, nil
 }
 
-func decodeKanji(version Version, buf *bitstream.Buffer) (Segment, error) {
+func decodeKanji(version Version, r *bitstream.SegmentReader) (Segment, error) {
 	var n int
 	switch {
 	case version <= 0 || version > 40:
@@ -305,11 +371,11 @@ func decodeKanji(version Version, buf *bitstream.Buffer) (Segment, error) {
 	default:
 		n = 12
 	}
-	length, err := buf.ReadBits(n)
+	length, err := r.Length(n, bitstream.KanjiBits)
 	if err != nil {
 		return Segment{}, err
 	}
-	data, err := bitstream.DecodeKanji(buf, int(length))
+	data, err := r.Kanji(length)
 	if err != nil {
 		return Segment{}, err
 	}
@@ -213,3 +213,32 @@ func TestDecode1(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestDecodeBitmap_CorrectionCapacity(t *testing.T) {
+	// 1-H has 17 error correction codewords, which correct up to 8 codeword errors.
+	qr, err := New([]byte("HELLO"), WithLevel(LevelH), WithVersion(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	img, err := qr.EncodeToBitmap()
+	if err != nil {
+		t.Fatal(err)
+	}
+	m, err := CodewordMap(1, LevelH)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, p := range m {
+		if p.Codeword < 8 {
+			img.SetBinary(p.X, p.Y, !img.BinaryAt(p.X, p.Y))
+		}
+	}
+
+	got, err := DecodeBitmap(img)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got.Segments[0].Data) != "HELLO" {
+		t.Errorf("got %q, want %q", got.Segments[0].Data, "HELLO")
+	}
+}
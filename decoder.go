@@ -0,0 +1,53 @@
+package qrcode
+
+import (
+	"image"
+
+	"github.com/shogo82148/qrcode/internal/grading"
+	"github.com/shogo82148/qrcode/internal/tracking"
+)
+
+// Decoder decodes QR Codes in the successive grayscale frames of a video,
+// such as the Y planes of a Y4M file or the raw frames from a camera.
+// Each symbol in view is searched near where it was found in the previous frame,
+// so the whole frame is searched only occasionally.
+// The whole frame is decoded as a still image with the symbols in view masked out,
+// so a new symbol is found only if no other new symbol is in view.
+// A Decoder is not safe for concurrent use.
+type Decoder struct {
+	tracker tracking.Tracker[*QRCode]
+}
+
+// NewDecoder returns a new Decoder that decodes the symbols with [DecodeImage].
+func NewDecoder(opts ...DecodeOptions) *Decoder {
+	d := &Decoder{}
+	d.tracker.Decode = func(img *grading.Image) (*QRCode, image.Rectangle, string, error) {
+		qr, bounds, err := decodeGrading(img, opts)
+		if err != nil {
+			return nil, image.Rectangle{}, "", err
+		}
+		return qr, bounds, payload(qr.Segments), nil
+	}
+	return d
+}
+
+// Decode decodes frame, the next frame of the video, and returns the symbols that have come into view.
+// A symbol is not returned again while it stays in view.
+// The symbols with the same data are the same symbol.
+func (d *Decoder) Decode(frame image.Image) []*QRCode {
+	return d.tracker.Next(frame)
+}
+
+// Reset forgets the symbols in view, for example at the start of another video.
+func (d *Decoder) Reset() {
+	d.tracker.Reset()
+}
+
+// payload returns the concatenated data of segments.
+func payload(segments []Segment) string {
+	var buf []byte
+	for _, seg := range segments {
+		buf = append(buf, seg.Data...)
+	}
+	return string(buf)
+}
@@ -0,0 +1,41 @@
+package qrcode
+
+import (
+	"image"
+	"image/color"
+	"testing"
+)
+
+func TestDrawTransform(t *testing.T) {
+	qr, err := New([]byte("https://github.com/shogo82148/qrcode"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := qr.EncodeToBitmap()
+	if err != nil {
+		t.Fatal(err)
+	}
+	n := want.Bounds().Dx()
+
+	dst := image.NewRGBA(image.Rect(0, 0, 400, 400))
+	for i := range dst.Pix {
+		dst.Pix[i] = 0xff
+	}
+	red := color.NRGBA{0xff, 0, 0, 0xff}
+	m := [6]float64{8, 0, 50, 0, 8, 60}
+	if err := qr.DrawTransform(dst, m, WithForeground(red), WithQuietZone(2)); err != nil {
+		t.Fatal(err)
+	}
+	for y := 0; y < n; y++ {
+		for x := 0; x < n; x++ {
+			c := dst.RGBAAt(50+x*8+4, 60+y*8+4)
+			got := c.G < 0x80
+			if got != bool(want.BinaryAt(x, y)) {
+				t.Fatalf("module (%d, %d) mismatch", x, y)
+			}
+			if got && c.R != 0xff {
+				t.Fatalf("module (%d, %d) is not red: %v", x, y, c)
+			}
+		}
+	}
+}
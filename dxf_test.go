@@ -0,0 +1,57 @@
+package qrcode
+
+import (
+	"bufio"
+	"bytes"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestEncodeDXF(t *testing.T) {
+	qr, err := New([]byte("https://github.com/shogo82148/qrcode"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if err := qr.EncodeDXF(&buf, WithModuleSizeMM(0.5), WithQuietZone(2), WithHatch(0.1)); err != nil {
+		t.Fatal(err)
+	}
+
+	// all points are in the symbol with the quiet zone.
+	size := float64(17+4*int(qr.Version)+2*2) * 0.5
+	var polylines, lines int
+	s := bufio.NewScanner(&buf)
+	for s.Scan() {
+		code := strings.TrimSpace(s.Text())
+		if !s.Scan() {
+			t.Fatal("unexpected EOF")
+		}
+		value := s.Text()
+		switch code {
+		case "0":
+			switch value {
+			case "POLYLINE":
+				polylines++
+			case "LINE":
+				lines++
+			}
+		case "10", "11", "20", "21":
+			v, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if v < 1 || v > size-1 {
+				if v != 0 {
+					t.Errorf("the point is out of the symbol: %s", value)
+				}
+			}
+		}
+	}
+	if polylines == 0 {
+		t.Error("no polylines")
+	}
+	if lines == 0 {
+		t.Error("no hatch lines")
+	}
+}
@@ -6,6 +6,8 @@ import (
 	"errors"
 	"fmt"
 	"image"
+	"image/color"
+	"image/draw"
 	"math"
 	"unicode/utf8"
 
@@ -16,6 +18,7 @@ import (
 	"github.com/shogo82148/go-imaging/srgb"
 	internalbitmap "github.com/shogo82148/qrcode/internal/bitmap"
 	"github.com/shogo82148/qrcode/internal/bitstream"
+	"github.com/shogo82148/qrcode/internal/raster"
 	"github.com/shogo82148/qrcode/internal/reedsolomon"
 )
 
@@ -25,11 +28,25 @@ func New(data []byte, opts ...EncodeOptions) (*QRCode, error) {
 	if !lv.IsValid() {
 		return nil, fmt.Errorf("qrcode: invalid level: %d", lv)
 	}
+	var qr *QRCode
+	var err error
 	if myopts.Kanji {
-		return newFromKanji(lv, data)
+		qr, err = newFromKanji(lv, data)
 	} else {
-		return newQR(lv, data)
+		qr, err = newQR(lv, data)
 	}
+	if err != nil {
+		return nil, err
+	}
+	if qr.Version < myopts.Version {
+		qr.Version = myopts.Version
+	}
+	if myopts.Logo != nil {
+		if err := qr.fitLogo(myopts.LogoSize); err != nil {
+			return nil, err
+		}
+	}
+	return qr, nil
 }
 
 func newQR(level Level, data []byte) (*QRCode, error) {
@@ -393,24 +410,27 @@ func calcVersion(level Level, segments []Segment) Version {
 		return 0
 	}
 
-LOOP:
 	for version := Version(1); version <= 40; version++ {
-		capacity := capacityTable[version][level].Data * 8
-		length := 0
-		for _, s := range segments {
-			l := s.length(version)
-			length += l
-			if length > capacity {
-				continue LOOP
-			}
-		}
-		if length <= capacity {
+		if fitsIn(version, level, segments) {
 			return version
 		}
 	}
 	return 0
 }
 
+// fitsIn reports whether segments fit in the symbol of version and level.
+func fitsIn(version Version, level Level, segments []Segment) bool {
+	capacity := capacityTable[version][level].Data * 8
+	length := 0
+	for _, s := range segments {
+		length += s.length(version)
+		if length > capacity {
+			return false
+		}
+	}
+	return true
+}
+
 const timingPatternOffset = 6
 
 func skipTimingPattern(n int) int {
@@ -423,11 +443,34 @@ func skipTimingPattern(n int) int {
 type EncodeOptions func(opts *encodeOptions)
 
 type encodeOptions struct {
-	QuietZone  int
-	ModuleSize float64
-	Level      Level
-	Kanji      bool
-	Width      int
+	QuietZone         int
+	ModuleSize        float64
+	Level             Level
+	Version           Version
+	Kanji             bool
+	Width             int
+	DPI               float64
+	ModuleSizeMM      float64
+	BarWidthReduction int
+	Hatch             float64
+	Base              float64
+	Height            float64
+	Engraved          bool
+	Inverted          bool
+	Rotation          float64
+	Native            bool
+	Plain             bool
+	TextBlack         string
+	TextWhite         string
+	Shape             Shape
+	FinderShape       FinderShape
+	Foreground        color.Color
+	Background        color.Color
+	FinderColor       color.Color
+	Logo              image.Image
+	LogoSize          float64
+	LogoOverlay       bool
+	Halftone          bool
 }
 
 func newEncodeOptions(opts ...EncodeOptions) encodeOptions {
@@ -437,6 +480,10 @@ func newEncodeOptions(opts ...EncodeOptions) encodeOptions {
 		Level:      LevelM,
 		Kanji:      true,
 		Width:      0,
+		TextBlack:  "#",
+		TextWhite:  " ",
+		Base:       2,
+		Height:     1,
 	}
 	for _, o := range opts {
 		o(&myopts)
@@ -472,6 +519,18 @@ func WithLevel(lv Level) EncodeOptions {
 	}
 }
 
+// WithVersion sets the minimum version.
+// The default version is 0, and the smallest version that fits the data is used.
+// If the version is invalid, it panics.
+func WithVersion(version Version) EncodeOptions {
+	if !version.IsValid() {
+		panic(fmt.Sprintf("qrcode: invalid version: %d", version))
+	}
+	return func(opts *encodeOptions) {
+		opts.Version = version
+	}
+}
+
 // WithKanji sets the kanji mode.
 // The default mode is true.
 // If it's enabled, Shift-JIS encoding is used for kanji mode.
@@ -490,6 +549,114 @@ func WithWidth(width int) EncodeOptions {
 	}
 }
 
+// WithDPI sets the resolution of the image in dots per inch.
+// It is recorded in the output formats that support it, such as PNG.
+// The default value is 0, which means that the resolution is not recorded.
+func WithDPI(dpi float64) EncodeOptions {
+	return func(opts *encodeOptions) {
+		opts.DPI = dpi
+	}
+}
+
+// WithModuleSizeMM sets the module size in millimeters for printing.
+// It requires the resolution set by [WithDPI],
+// and the module size is snapped to whole printer dots.
+// It overrides [WithModuleSize].
+func WithModuleSizeMM(mm float64) EncodeOptions {
+	return func(opts *encodeOptions) {
+		opts.ModuleSizeMM = mm
+	}
+}
+
+// WithBarWidthReduction sets the number of pixels (printer dots)
+// removed from the width of dark modules to compensate the dot gain in printing.
+// The edges of dark modules next to light modules are shrunk,
+// and half of the reduction is applied to each side.
+// The reduction is less than the module size.
+// With the styles such as [WithShape], the dark paint is eroded by the reduction.
+// The default value is 0.
+func WithBarWidthReduction(dots int) EncodeOptions {
+	return func(opts *encodeOptions) {
+		opts.BarWidthReduction = dots
+	}
+}
+
+// WithHatch sets the line spacing in millimeters of the fill hatching in vector outputs.
+// The default value is 0, and the dark modules are not filled.
+func WithHatch(spacing float64) EncodeOptions {
+	return func(opts *encodeOptions) {
+		opts.Hatch = spacing
+	}
+}
+
+// WithExtrusion sets the thickness of the base plate and the height of the modules in millimeters for 3D models.
+// The default values are 2 mm and 1 mm, and both must be positive.
+func WithExtrusion(base, height float64) EncodeOptions {
+	return func(opts *encodeOptions) {
+		opts.Base = base
+		opts.Height = height
+	}
+}
+
+// WithEngraved sets whether the dark modules are engraved into 3D models.
+// The default value is false, and the dark modules are raised.
+// If it's enabled, the light modules and the quiet zone are raised instead.
+func WithEngraved(engraved bool) EncodeOptions {
+	return func(opts *encodeOptions) {
+		opts.Engraved = engraved
+	}
+}
+
+// WithInverted sets whether the symbol is rendered light on dark.
+// The default value is false.
+// If it's enabled, the dark modules and the light modules are swapped in the images and the text,
+// and the quiet zone is dark.
+// Inverted symbols suit laser etching on dark materials and backlit displays.
+func WithInverted(inverted bool) EncodeOptions {
+	return func(opts *encodeOptions) {
+		opts.Inverted = inverted
+	}
+}
+
+// WithRotation sets the clockwise rotation in degrees for [QRCode.Draw].
+// The default value is 0.
+func WithRotation(degrees float64) EncodeOptions {
+	return func(opts *encodeOptions) {
+		opts.Rotation = degrees
+	}
+}
+
+// WithNative sets whether the printer's built-in QR Code command is used in ZPL.
+// The default value is false, and the exact module matrix is sent as a graphic.
+func WithNative(native bool) EncodeOptions {
+	return func(opts *encodeOptions) {
+		opts.Native = native
+	}
+}
+
+// printing reports whether the options require the rendering for printing.
+func (opts *encodeOptions) printing() bool {
+	return opts.ModuleSizeMM > 0 || opts.BarWidthReduction != 0
+}
+
+// WithPlain sets whether the plain (ASCII) formats are used for Netpbm images and STL models.
+// The default value is false, and the raw (binary) formats are used.
+func WithPlain(plain bool) EncodeOptions {
+	return func(opts *encodeOptions) {
+		opts.Plain = plain
+	}
+}
+
+// WithTextChars sets the characters of black and white modules for the text output.
+// The default characters are "#" and " ".
+// Both should have the same width, e.g. "██" and "  " make modules look square in terminals.
+func WithTextChars(black, white string) EncodeOptions {
+	return func(opts *encodeOptions) {
+		opts.TextBlack = black
+		opts.TextWhite = white
+	}
+}
+
 func Encode(data []byte, opts ...EncodeOptions) (image.Image, error) {
 	qr, err := New(data, opts...)
 	if err != nil {
@@ -513,6 +680,47 @@ func (qr *QRCode) Encode(opts ...EncodeOptions) (image.Image, error) {
 		return nil, err
 	}
 
+	var area image.Rectangle
+	if myopts.Logo != nil {
+		area = logoArea(qr.Version, myopts.LogoSize)
+		if err := checkLogo(qr.Version, qr.Level, area); err != nil {
+			return nil, err
+		}
+		if !myopts.LogoOverlay {
+			clearLogoArea(binimg, qr.Version, area)
+		}
+	}
+
+	var img *image.NRGBA
+	switch {
+	case myopts.styled():
+		img, err = qr.encodeStyled(binimg, myopts)
+		if err != nil {
+			return nil, err
+		}
+	case myopts.printing():
+		l, err := newLayout(binimg, myopts)
+		if err != nil {
+			return nil, err
+		}
+		if myopts.Logo == nil {
+			return raster.Gray(binimg, l), nil
+		}
+		img = image.NewNRGBA(l.Rect)
+		draw.Draw(img, l.Rect, raster.Gray(binimg, l), l.Rect.Min, draw.Src)
+	default:
+		img = encodeImage(binimg, myopts)
+	}
+	if myopts.Logo != nil {
+		scale := float64(img.Bounds().Dx()) / float64(binimg.Bounds().Dx()+myopts.QuietZone*2)
+		drawLogo(img, myopts.Logo, area, myopts.QuietZone, scale)
+	}
+	return img, nil
+}
+
+// encodeImage converts binimg to an image with the quiet zone.
+func encodeImage(binimg *bitmap.Image, myopts encodeOptions) *image.NRGBA {
+
 	w := binimg.Bounds().Dx() + myopts.QuietZone*2
 
 	// convert bitmap to image
@@ -521,7 +729,7 @@ func (qr *QRCode) Encode(opts ...EncodeOptions) (image.Image, error) {
 	white := fp16color.NewNRGBAh(1, 1, 1, 1)
 	for y := 0; y < w; y++ {
 		for x := 0; x < w; x++ {
-			c := binimg.BinaryAt(x-myopts.QuietZone, y-myopts.QuietZone)
+			c := binimg.BinaryAt(x-myopts.QuietZone, y-myopts.QuietZone) != bitmap.Color(myopts.Inverted)
 			if c {
 				src.SetNRGBAh(x, y, black)
 			} else {
@@ -538,7 +746,7 @@ func (qr *QRCode) Encode(opts ...EncodeOptions) (image.Image, error) {
 	dst := fp16.NewNRGBAh(image.Rect(0, 0, W, W))
 	resize.AreaAverage(dst, src)
 
-	return srgb.EncodeTone(dst), nil
+	return srgb.EncodeTone(dst)
 }
 
 // EncodeToBitmap encodes QR Code into bitmap image.
@@ -555,55 +763,18 @@ func (qr *QRCode) EncodeToBitmap() (*bitmap.Image, error) {
 		return nil, err
 	}
 
-	w := 16 + 4*int(qr.Version)
 	img := baseList[qr.Version].Clone()
 	used := usedList[qr.Version]
-
-	dy := -1
-	x, y := w, w
-	for {
-		if x == timingPatternOffset {
-			// skip timing pattern
-			x--
-			continue
-		}
-		if !used.BinaryAt(x, y) {
-			bit, err := buf.ReadBit()
-			if err != nil {
-				break
-			}
-			img.SetBinary(x, y, bit != 0)
-		}
-		x--
-		if x < 0 {
-			break
-		}
-
-		if !used.BinaryAt(x, y) {
-			bit, err := buf.ReadBit()
-			if err != nil {
-				break
-			}
-			img.SetBinary(x, y, bit != 0)
-		}
-		x, y = x+1, y+dy
-		if y < 0 || y > w {
-			dy *= -1
-			x, y = x-2, y+dy
-		}
-		if x < 0 {
+	for _, pt := range placement(qr.Version) {
+		bit, err := buf.ReadBit()
+		if err != nil {
 			break
 		}
+		img.SetBinary(pt.X, pt.Y, bit != 0)
 	}
 
 	// version
-	if qr.Version >= 7 {
-		version := encodedVersion[qr.Version]
-		for i := 0; i < 18; i++ {
-			img.SetBinary(i/3, w-10+i%3, (version>>i)&1 != 0)
-			img.SetBinary(w-10+i%3, i/3, (version>>i)&1 != 0)
-		}
-	}
+	writeVersion(img, qr.Version)
 
 	// mask
 	mask := qr.Mask
@@ -613,15 +784,7 @@ func (qr *QRCode) EncodeToBitmap() (*bitmap.Image, error) {
 		mask = Mask0
 		for i := Mask0; i < maskMax; i++ {
 			tmp.Mask(img, used, maskList[i])
-			format := encodedFormat[int(qr.Level)<<3+int(i)]
-			for i := 0; i < 8; i++ {
-				tmp.SetBinary(8, skipTimingPattern(i), (format>>i)&1 != 0)
-				tmp.SetBinary(skipTimingPattern(i), 8, (format>>(14-i))&1 != 0)
-
-				tmp.SetBinary(w-i, 8, (format>>i)&1 != 0)
-				tmp.SetBinary(8, w-i, (format>>(14-i))&1 != 0)
-			}
-			tmp.SetBinary(8, w-7, internalbitmap.Black)
+			writeFormat(&tmp, qr.Level, i)
 
 			point := tmp.Point()
 			if point < minPoint {
@@ -632,7 +795,29 @@ func (qr *QRCode) EncodeToBitmap() (*bitmap.Image, error) {
 	}
 
 	// format
-	format := encodedFormat[int(qr.Level)<<3+int(mask)]
+	writeFormat(img, qr.Level, mask)
+	img.Mask(img, used, maskList[mask])
+
+	return img.Export(), nil
+}
+
+// writeVersion writes the version information into img.
+func writeVersion(img *internalbitmap.Image, version Version) {
+	if version < 7 {
+		return
+	}
+	w := img.Rect.Dx() - 1
+	encoded := encodedVersion[version]
+	for i := 0; i < 18; i++ {
+		img.SetBinary(i/3, w-10+i%3, (encoded>>i)&1 != 0)
+		img.SetBinary(w-10+i%3, i/3, (encoded>>i)&1 != 0)
+	}
+}
+
+// writeFormat writes the format information and the dark module into img.
+func writeFormat(img *internalbitmap.Image, level Level, mask Mask) {
+	w := img.Rect.Dx() - 1
+	format := encodedFormat[int(level)<<3+int(mask)]
 	for i := 0; i < 8; i++ {
 		img.SetBinary(8, skipTimingPattern(i), (format>>i)&1 != 0)
 		img.SetBinary(skipTimingPattern(i), 8, (format>>(14-i))&1 != 0)
@@ -641,9 +826,6 @@ func (qr *QRCode) EncodeToBitmap() (*bitmap.Image, error) {
 		img.SetBinary(8, w-i, (format>>(14-i))&1 != 0)
 	}
 	img.SetBinary(8, w-7, internalbitmap.Black)
-	img.Mask(img, used, maskList[mask])
-
-	return img.Export(), nil
 }
 
 type block struct {
@@ -0,0 +1,88 @@
+package qrcode
+
+import (
+	"bufio"
+	"errors"
+	"io"
+
+	"github.com/shogo82148/qrcode/internal/raster"
+)
+
+// EncodeESCPOS encodes QR Code into ESC/POS commands for thermal receipt printers, and writes them to w.
+//
+// The printer's GS ( k command is used if it reproduces qr:
+// the mask is [MaskAuto] and the version is the smallest one for the segments,
+// which the printer chooses by itself, and the symbol is not inverted.
+// Then the module size is rounded to 1-16 dots, and the quiet zone is left to the printer.
+//
+// Otherwise, the image rendered in the same way as [QRCode.EncodePaletted]
+// is sent as a raster bit image (GS v 0), and the pixels are the printer dots.
+func (qr *QRCode) EncodeESCPOS(w io.Writer, opts ...EncodeOptions) error {
+	myopts := newEncodeOptions(opts...)
+	if data, ok := qr.escposData(myopts); ok {
+		moduleSize := min(16, max(1, round(myopts.ModuleSize)))
+		return writeESCPOS(w, data, qr.Level, moduleSize)
+	}
+
+	binimg, err := qr.EncodeToBitmap()
+	if err != nil {
+		return err
+	}
+	l, err := newLayout(binimg, myopts)
+	if err != nil {
+		return err
+	}
+	return raster.EncodeESCPOS(w, binimg, l)
+}
+
+// escposData returns the data stored by the GS ( k command.
+// ok is false if the printer can't reproduce qr.
+func (qr *QRCode) escposData(myopts encodeOptions) (data []byte, ok bool) {
+	if myopts.Inverted || qr.Mask != MaskAuto || !qr.Level.IsValid() || len(qr.Segments) == 0 {
+		return nil, false
+	}
+	if qr.Version != calcVersion(qr.Level, qr.Segments) {
+		return nil, false
+	}
+	for _, seg := range qr.Segments {
+		payload, err := printerPayload(seg)
+		if err != nil {
+			return nil, false
+		}
+		data = append(data, payload...)
+	}
+	if len(data) > 7089 {
+		return nil, false
+	}
+	return data, true
+}
+
+// writeESCPOS writes the GS ( k commands that print the QR Code of data.
+func writeESCPOS(w io.Writer, data []byte, level Level, moduleSize int) error {
+	var lv byte
+	switch level {
+	case LevelL:
+		lv = 48
+	case LevelM:
+		lv = 49
+	case LevelQ:
+		lv = 50
+	case LevelH:
+		lv = 51
+	default:
+		return errors.New("qrcode: invalid level")
+	}
+
+	bw := bufio.NewWriter(w)
+	gsk := func(fn byte, params ...byte) {
+		n := len(params) + 2
+		bw.Write([]byte{0x1d, '(', 'k', byte(n), byte(n >> 8), 49, fn})
+		bw.Write(params)
+	}
+	gsk(65, 50, 0)                          // select the model 2
+	gsk(67, byte(moduleSize))               // set the module size
+	gsk(69, lv)                             // set the error correction level
+	gsk(80, append([]byte{48}, data...)...) // store the data
+	gsk(81, 48)                             // print the symbol
+	return bw.Flush()
+}
@@ -0,0 +1,56 @@
+package qrcode
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestEncodeESCPOS(t *testing.T) {
+	qr, err := New([]byte("0123"), WithLevel(LevelQ))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	if err := qr.EncodeESCPOS(&buf, WithModuleSize(6)); err != nil {
+		t.Fatal(err)
+	}
+	want := []byte{
+		0x1d, '(', 'k', 4, 0, 49, 65, 50, 0,
+		0x1d, '(', 'k', 3, 0, 49, 67, 6,
+		0x1d, '(', 'k', 3, 0, 49, 69, 50,
+		0x1d, '(', 'k', 7, 0, 49, 80, 48, '0', '1', '2', '3',
+		0x1d, '(', 'k', 3, 0, 49, 81, 48,
+	}
+	if got := buf.Bytes(); !bytes.Equal(got, want) {
+		t.Errorf("got %x, want %x", got, want)
+	}
+}
+
+func TestEncodeESCPOS_Raster(t *testing.T) {
+	tests := []struct {
+		name string
+		qr   func(qr *QRCode)
+		opts []EncodeOptions
+	}{
+		{"mask", func(qr *QRCode) { qr.Mask = Mask3 }, nil},
+		{"version", func(qr *QRCode) { qr.Version = 5 }, nil},
+		{"inverted", func(qr *QRCode) {}, []EncodeOptions{WithInverted(true)}},
+	}
+	for _, tt := range tests {
+		qr, err := New([]byte("0123"), WithLevel(LevelQ))
+		if err != nil {
+			t.Fatal(err)
+		}
+		tt.qr(qr)
+
+		// the printer can't reproduce the symbol, so it is sent as an image.
+		var buf bytes.Buffer
+		if err := qr.EncodeESCPOS(&buf, append(tt.opts, WithModuleSize(6))...); err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.HasPrefix(buf.Bytes(), []byte{0x1d, 'v', '0', 0}) {
+			t.Errorf("%s: want raster bit image, got %x", tt.name, buf.Bytes()[:8])
+		}
+	}
+}
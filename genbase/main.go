@@ -39,6 +39,7 @@ func main() {
 
 	genMaskList(&buf)
 	genBaseList(&buf)
+	genAlignmentPatternPositions(&buf)
 
 	out, err := format.Source(buf.Bytes())
 	if err != nil {
@@ -100,6 +101,25 @@ func genBaseList(buf *bytes.Buffer) {
 	fmt.Fprintf(buf, "}\n")
 }
 
+func genAlignmentPatternPositions(buf *bytes.Buffer) {
+	fmt.Fprintf(buf, "\n// alignmentPatternPositions is the list of the center coordinates of alignment patterns.\n")
+	fmt.Fprintf(buf, "// The patterns are placed at all combinations of them except the ones overlapping finder patterns.\n")
+	fmt.Fprintf(buf, "var alignmentPatternPositions = [][]int{\n")
+	fmt.Fprintf(buf, "nil, // dummy\n")
+	for version := 1; version <= 40; version++ {
+		fmt.Fprintf(buf, "{")
+		for i, pos := range positions[version] {
+			if i > 0 {
+				fmt.Fprintf(buf, ", ")
+			}
+			fmt.Fprintf(buf, "%d", pos)
+		}
+		fmt.Fprintf(buf, "}, // Version %d\n", version)
+	}
+	fmt.Fprintf(buf, "}\n")
+}
+
+// positions is the list of the center coordinates of alignment patterns in ISO/IEC 18004 Annex E.
 var positions = [][]int{
 	nil, // dummy
 
@@ -136,14 +156,14 @@ var positions = [][]int{
 
 	{6, 26, 50, 74, 98, 122},  // Version 28
 	{6, 30, 54, 78, 102, 126}, // Version 29
-	{6, 26, 52, 77, 104, 130}, // Version 30
+	{6, 26, 52, 78, 104, 130}, // Version 30
 	{6, 30, 56, 82, 108, 134}, // Version 31
 	{6, 34, 60, 86, 112, 138}, // Version 32
 	{6, 30, 58, 86, 114, 142}, // Version 33
 	{6, 34, 62, 90, 118, 146}, // Version 34
 
-	{6, 30, 46, 78, 102, 126, 150}, // Version 35
-	{6, 24, 48, 70, 102, 128, 154}, // Version 36
+	{6, 30, 54, 78, 102, 126, 150}, // Version 35
+	{6, 24, 50, 76, 102, 128, 154}, // Version 36
 	{6, 28, 54, 80, 106, 132, 158}, // Version 37
 	{6, 32, 58, 84, 110, 136, 162}, // Version 38
 	{6, 26, 54, 82, 110, 138, 166}, // Version 39
@@ -0,0 +1,396 @@
+package qrcode
+
+import (
+	"errors"
+	"image"
+	"math"
+	"math/bits"
+	"sort"
+
+	"github.com/shogo82148/go-imaging/bitmap"
+	internalbitmap "github.com/shogo82148/qrcode/internal/bitmap"
+	"github.com/shogo82148/qrcode/internal/bitstream"
+	"github.com/shogo82148/qrcode/internal/reedsolomon"
+)
+
+// WithHalftone sets whether [NewHalftone] renders the modules in the halftone style.
+// The default value is false, and each module is rendered as a square.
+//
+// In the halftone style, each module is split into 3x3 sub-modules.
+// The center one keeps the color of the module, and the others follow the picture.
+// The function patterns are not split.
+// The module size should be a multiple of 3 so that the sub-modules are sharp.
+func WithHalftone(halftone bool) EncodeOptions {
+	return func(opts *encodeOptions) {
+		opts.Halftone = halftone
+	}
+}
+
+// Halftone is a QR Code whose modules resemble a picture.
+type Halftone struct {
+	// QRCode is the symbol. Its Mask is the chosen mask pattern.
+	// The padding bits that resemble the picture are not kept in QRCode,
+	// so [QRCode.EncodeToBitmap] doesn't reproduce Bitmap.
+	QRCode *QRCode
+
+	// Bitmap is the modules of the symbol.
+	Bitmap *bitmap.Image
+
+	// Image is the rendering of the symbol with the quiet zone.
+	Image image.Image
+}
+
+// NewHalftone returns a QR Code of data whose modules resemble target.
+// target is stretched to the symbol without the quiet zone.
+//
+// The bits after the terminator are free to choose, because the decoders ignore them.
+// They are chosen so that the data and error correction modules match the dithered picture,
+// in the order of the contrast of the picture, and the mask pattern that matches best is used.
+// The segmentation of [New] is used to keep as many bits free as possible.
+// The symbol is a valid code word, so the error correction is not consumed.
+//
+// The version is the smallest one in which the data use at most half of the data codewords.
+// Use [WithVersion] for the finer picture.
+func NewHalftone(data []byte, target image.Image, opts ...EncodeOptions) (*Halftone, error) {
+	if target == nil {
+		return nil, errors.New("qrcode: no target image")
+	}
+	myopts := newEncodeOptions(opts...)
+	if myopts.Logo != nil {
+		return nil, errors.New("qrcode: logo is not supported in halftone")
+	}
+	qr, err := New(data, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if myopts.Version == 0 {
+		qr.Version = halftoneVersion(qr.Version, qr.Level, qr.Segments)
+	}
+
+	img, mask, err := qr.encodeHalftone(target)
+	if err != nil {
+		return nil, err
+	}
+	qr.Mask = mask
+
+	var rendering image.Image
+	if myopts.Halftone {
+		rendering = encodeImage(halftoneModules(img, target), halftoneOptions(myopts))
+	} else {
+		rendering = encodeImage(img, myopts)
+	}
+	return &Halftone{
+		QRCode: qr,
+		Bitmap: img,
+		Image:  rendering,
+	}, nil
+}
+
+// halftoneVersion returns the smallest version from version
+// in which segments use at most half of the data codewords.
+func halftoneVersion(version Version, level Level, segments []Segment) Version {
+	for v := version; v <= 40; v++ {
+		length := 4 // terminator
+		for _, s := range segments {
+			length += s.length(v)
+		}
+		if length*2 <= capacityTable[v][level].Data*8 {
+			return v
+		}
+	}
+	return version
+}
+
+// halftoneRow is a linear equation over GF(2) on the free bits of a block.
+// rhs holds the right hand sides for all eight mask patterns.
+type halftoneRow struct {
+	col int
+	vec []uint64
+	rhs uint8
+}
+
+// halftoneBlock is a Reed-Solomon block with the free bits.
+type halftoneBlock struct {
+	data   []byte     // the data codewords with the free bits cleared
+	start  int        // the index of the first free bit in the block
+	base   []byte     // the error correction codewords of data
+	ec     [][]uint64 // the free bits that affect each bit of the error correction codewords
+	pivots []halftoneRow
+}
+
+func (qr *QRCode) encodeHalftone(target image.Image) (*bitmap.Image, Mask, error) {
+	version, level := qr.Version, qr.Level
+	capacity := capacityTable[version][level]
+
+	// the fixed bits.
+	var buf bitstream.Buffer
+	for _, s := range qr.Segments {
+		if err := s.encode(version, &buf); err != nil {
+			return nil, 0, err
+		}
+	}
+	if buf.Len() > capacity.Data*8 {
+		return nil, 0, errors.New("qrcode: data is too large")
+	}
+	fixed := capacity.Data * 8
+	if capacity.Data*8-buf.Len() > 4 {
+		buf.WriteBitsLSB(uint64(ModeTerminated), 4)
+		fixed = buf.Len()
+	}
+	data := make([]byte, capacity.Data)
+	copy(data, buf.Bytes())
+
+	// the error correction codewords are linear in the free bits.
+	var blocks []*halftoneBlock
+	offset := 0
+	for _, blockCapacity := range capacity.Blocks {
+		for i := 0; i < blockCapacity.Num; i++ {
+			n := blockCapacity.Total - blockCapacity.Data
+			rs := reedsolomon.New(n)
+			blk := &halftoneBlock{
+				data:  data[offset : offset+blockCapacity.Data],
+				start: max(0, fixed-offset*8),
+			}
+			rs.Write(blk.data)
+			blk.base = rs.Sum(nil)
+
+			free := max(0, blockCapacity.Data*8-blk.start)
+			words := (free + 63) / 64
+			blk.ec = make([][]uint64, n*8)
+			for j := range blk.ec {
+				blk.ec[j] = make([]uint64, words)
+			}
+			unit := make([]byte, blockCapacity.Data)
+			for v := 0; v < free; v++ {
+				g := blk.start + v
+				unit[g/8] = 0x80 >> (g % 8)
+				rs.Reset()
+				rs.Write(unit)
+				for j, b := range rs.Sum(nil) {
+					for k := 0; k < 8; k++ {
+						if (b>>(7-k))&1 != 0 {
+							blk.ec[j*8+k][v/64] |= 1 << (v % 64)
+						}
+					}
+				}
+				unit[g/8] = 0
+			}
+			blocks = append(blocks, blk)
+			offset += blockCapacity.Data
+		}
+	}
+
+	// the picture.
+	n := 17 + 4*int(version)
+	gray := sampleGray(target, n)
+	dark := dither(gray, n)
+
+	// fix the free bits in the order of the contrast.
+	m, err := CodewordMap(version, level)
+	if err != nil {
+		return nil, 0, err
+	}
+	sort.SliceStable(m, func(i, j int) bool {
+		return math.Abs(gray[m[i].Y*n+m[i].X]-0.5) > math.Abs(gray[m[j].Y*n+m[j].X]-0.5)
+	})
+	for _, p := range m {
+		blk := blocks[p.Block]
+		var row halftoneRow
+		var c bool
+		if p.Index < len(blk.data) {
+			g := p.Index*8 + 7 - p.Bit
+			if g < blk.start {
+				// the fixed bit can't be changed.
+				continue
+			}
+			v := g - blk.start
+			row.vec = make([]uint64, len(blk.ec[0]))
+			row.vec[v/64] |= 1 << (v % 64)
+		} else {
+			j := (p.Index-len(blk.data))*8 + 7 - p.Bit
+			c = (blk.base[j/8]>>(7-j%8))&1 != 0
+			row.vec = append([]uint64(nil), blk.ec[j]...)
+		}
+		for mask := Mask0; mask < maskMax; mask++ {
+			want := dark[p.Y*n+p.X] != bool(maskList[mask].BinaryAt(p.X, p.Y))
+			if want != c {
+				row.rhs |= 1 << mask
+			}
+		}
+		blk.add(row)
+	}
+
+	// choose the best mask.
+	var best *internalbitmap.Image
+	var bestMask Mask
+	bestScore := math.Inf(-1)
+	used := usedList[version]
+	for mask := Mask0; mask < maskMax; mask++ {
+		var codewords [][]byte
+		for _, blk := range blocks {
+			codewords = append(codewords, blk.solve(mask))
+		}
+
+		img := baseList[version].Clone()
+		for _, p := range m {
+			img.SetBinary(p.X, p.Y, (codewords[p.Block][p.Index]>>p.Bit)&1 != 0)
+		}
+		writeVersion(img, version)
+		writeFormat(img, level, mask)
+		img.Mask(img, used, maskList[mask])
+
+		var score float64
+		for _, p := range m {
+			if bool(img.BinaryAt(p.X, p.Y)) == dark[p.Y*n+p.X] {
+				score += math.Abs(gray[p.Y*n+p.X]-0.5) + 0.5
+			}
+		}
+		if score > bestScore {
+			best, bestMask, bestScore = img, mask, score
+		}
+	}
+	return best.Export(), bestMask, nil
+}
+
+// add adds row to the equations if it is independent of them.
+func (blk *halftoneBlock) add(row halftoneRow) {
+	for _, p := range blk.pivots {
+		if (row.vec[p.col/64]>>(p.col%64))&1 == 0 {
+			continue
+		}
+		for i := range row.vec {
+			row.vec[i] ^= p.vec[i]
+		}
+		row.rhs ^= p.rhs
+	}
+	for i, w := range row.vec {
+		if w != 0 {
+			row.col = i*64 + bits.TrailingZeros64(w)
+			blk.pivots = append(blk.pivots, row)
+			return
+		}
+	}
+}
+
+// solve returns the data and error correction codewords of the block for mask.
+func (blk *halftoneBlock) solve(mask Mask) []byte {
+	x := make([]uint64, len(blk.ec[0]))
+	for i := len(blk.pivots) - 1; i >= 0; i-- {
+		p := blk.pivots[i]
+		v := int(p.rhs>>mask) & 1
+		for j, w := range p.vec {
+			v ^= bits.OnesCount64(w&x[j]) & 1
+		}
+		if v != 0 {
+			x[p.col/64] |= 1 << (p.col % 64)
+		}
+	}
+
+	data := append([]byte(nil), blk.data...)
+	for i := 0; i < len(data)*8-blk.start; i++ {
+		if (x[i/64]>>(i%64))&1 != 0 {
+			g := blk.start + i
+			data[g/8] |= 0x80 >> (g % 8)
+		}
+	}
+	rs := reedsolomon.New(len(blk.base))
+	rs.Write(data)
+	return append(data, rs.Sum(nil)...)
+}
+
+// sampleGray returns the luminance of target in the n x n grid, from 0 (black) to 1 (white).
+// The transparent pixels are composited over white.
+func sampleGray(target image.Image, n int) []float64 {
+	bounds := target.Bounds()
+	ret := make([]float64, n*n)
+	for j := 0; j < n; j++ {
+		y0 := bounds.Min.Y + j*bounds.Dy()/n
+		y1 := max(y0+1, bounds.Min.Y+(j+1)*bounds.Dy()/n)
+		for i := 0; i < n; i++ {
+			x0 := bounds.Min.X + i*bounds.Dx()/n
+			x1 := max(x0+1, bounds.Min.X+(i+1)*bounds.Dx()/n)
+			var sum float64
+			var cnt int
+			for y := y0; y < y1 && y < bounds.Max.Y; y++ {
+				for x := x0; x < x1 && x < bounds.Max.X; x++ {
+					r, g, b, a := target.At(x, y).RGBA()
+					lum := (19595*r+38470*g+7471*b+1<<15)>>16 + (0xffff - a)
+					sum += min(1, float64(lum)/0xffff)
+					cnt++
+				}
+			}
+			if cnt == 0 {
+				ret[j*n+i] = 1
+				continue
+			}
+			ret[j*n+i] = sum / float64(cnt)
+		}
+	}
+	return ret
+}
+
+// dither returns the dark pixels of gray in the n x n grid,
+// using the Floyd-Steinberg dithering.
+func dither(gray []float64, n int) []bool {
+	v := append([]float64(nil), gray...)
+	ret := make([]bool, n*n)
+	for y := 0; y < n; y++ {
+		for x := 0; x < n; x++ {
+			old := v[y*n+x]
+			var e float64
+			if old < 0.5 {
+				ret[y*n+x] = true
+				e = old
+			} else {
+				e = old - 1
+			}
+			if x+1 < n {
+				v[y*n+x+1] += e * 7 / 16
+			}
+			if y+1 < n {
+				if x > 0 {
+					v[(y+1)*n+x-1] += e * 3 / 16
+				}
+				v[(y+1)*n+x] += e * 5 / 16
+				if x+1 < n {
+					v[(y+1)*n+x+1] += e * 1 / 16
+				}
+			}
+		}
+	}
+	return ret
+}
+
+// halftoneModules splits the modules of img into 3x3 sub-modules.
+// The sub-modules except the center follow target.
+func halftoneModules(img *bitmap.Image, target image.Image) *bitmap.Image {
+	n := img.Bounds().Dx()
+	dark := dither(sampleGray(target, n*3), n*3)
+	version := Version((n - 17) / 4)
+	used := usedList[version]
+
+	ret := bitmap.New(image.Rect(0, 0, n*3, n*3))
+	for y := 0; y < n; y++ {
+		for x := 0; x < n; x++ {
+			c := img.BinaryAt(x, y)
+			for j := 0; j < 3; j++ {
+				for i := 0; i < 3; i++ {
+					X, Y := x*3+i, y*3+j
+					if used.BinaryAt(x, y) || (i == 1 && j == 1) {
+						ret.SetBinary(X, Y, c)
+					} else {
+						ret.SetBinary(X, Y, bitmap.Color(dark[Y*n*3+X]))
+					}
+				}
+			}
+		}
+	}
+	return ret
+}
+
+// halftoneOptions returns the options to render the sub-modules.
+func halftoneOptions(myopts encodeOptions) encodeOptions {
+	myopts.QuietZone *= 3
+	myopts.ModuleSize /= 3
+	return myopts
+}
@@ -0,0 +1,117 @@
+package qrcode
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"testing"
+
+	"github.com/shogo82148/go-imaging/bitmap"
+)
+
+// testPicture returns a dark disc on the white background.
+func testPicture(size int) image.Image {
+	img := image.NewGray(image.Rect(0, 0, size, size))
+	r := float64(size) / 3
+	c := float64(size) / 2
+	for y := 0; y < size; y++ {
+		for x := 0; x < size; x++ {
+			dx, dy := float64(x)+0.5-c, float64(y)+0.5-c
+			if dx*dx+dy*dy < r*r {
+				img.SetGray(x, y, color.Gray{Y: 0})
+			} else {
+				img.SetGray(x, y, color.Gray{Y: 255})
+			}
+		}
+	}
+	return img
+}
+
+// matches returns the number of the data and error correction modules that match target.
+func matches(t *testing.T, img *bitmap.Image, version Version, level Level, target image.Image) int {
+	t.Helper()
+	m, err := CodewordMap(version, level)
+	if err != nil {
+		t.Fatal(err)
+	}
+	n := 17 + 4*int(version)
+	dark := dither(sampleGray(target, n), n)
+	var count int
+	for _, p := range m {
+		if bool(img.BinaryAt(p.X, p.Y)) == dark[p.Y*n+p.X] {
+			count++
+		}
+	}
+	return count
+}
+
+func TestNewHalftone(t *testing.T) {
+	data := []byte("https://example.com/")
+	target := testPicture(200)
+	ht, err := NewHalftone(data, target, WithVersion(7), WithLevel(LevelL))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ht.QRCode.Version != 7 {
+		t.Errorf("unexpected version: got %d, want %d", ht.QRCode.Version, 7)
+	}
+
+	// the symbol resembles the picture better than the plain one.
+	plain, err := New(data, WithVersion(7), WithLevel(LevelL))
+	if err != nil {
+		t.Fatal(err)
+	}
+	plainImg, err := plain.EncodeToBitmap()
+	if err != nil {
+		t.Fatal(err)
+	}
+	m0 := matches(t, plainImg, 7, LevelL, target)
+	m1 := matches(t, ht.Bitmap, 7, LevelL, target)
+	if m1 <= m0 {
+		t.Errorf("halftone doesn't resemble the picture: %d <= %d", m1, m0)
+	}
+
+	// the symbol decodes correctly.
+	got, err := DecodeBitmap(ht.Bitmap)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded []byte
+	for _, seg := range got.Segments {
+		decoded = append(decoded, seg.Data...)
+	}
+	if !bytes.Equal(decoded, data) {
+		t.Errorf("got %q, want %q", decoded, data)
+	}
+	if got.Mask != ht.QRCode.Mask {
+		t.Errorf("unexpected mask: got %d, want %d", got.Mask, ht.QRCode.Mask)
+	}
+
+	size := 17 + 4*7 + 8
+	if got, want := ht.Image.Bounds(), image.Rect(0, 0, size, size); got != want {
+		t.Errorf("unexpected bounds: got %v, want %v", got, want)
+	}
+}
+
+func TestNewHalftone_Halftone(t *testing.T) {
+	data := []byte("HALFTONE")
+	ht, err := NewHalftone(data, testPicture(100), WithHalftone(true), WithModuleSize(3))
+	if err != nil {
+		t.Fatal(err)
+	}
+	n := ht.Bitmap.Bounds().Dx()
+	size := (n + 8) * 3
+	if got, want := ht.Image.Bounds(), image.Rect(0, 0, size, size); got != want {
+		t.Fatalf("unexpected bounds: got %v, want %v", got, want)
+	}
+
+	// the centers of the modules keep their colors.
+	for y := 0; y < n; y++ {
+		for x := 0; x < n; x++ {
+			r, _, _, _ := ht.Image.At((x+4)*3+1, (y+4)*3+1).RGBA()
+			if got, want := r < 0x8000, bool(ht.Bitmap.BinaryAt(x, y)); got != want {
+				t.Fatalf("module (%d, %d): got %t, want %t", x, y, got, want)
+			}
+		}
+	}
+}
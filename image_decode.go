@@ -0,0 +1,182 @@
+package qrcode
+
+import (
+	"errors"
+	"image"
+	"math"
+
+	"github.com/shogo82148/qrcode/internal/grading"
+	"github.com/shogo82148/qrcode/internal/sampling"
+)
+
+// DecodeImage decodes a QR Code in img, which is a captured grayscale image of an upright symbol
+// surrounded by the quiet zone.
+// The symbol may be warped, for example printed on a curved bottle or a wrinkled bag.
+// The modules are sampled on the piecewise grid fitted to the finder patterns,
+// the alignment patterns and the timing patterns,
+// and each module is the majority of the pixels sampled in it.
+// If the finder patterns are not found, the colors are inverted and they are searched again.
+func DecodeImage(img image.Image, opts ...DecodeOptions) (*QRCode, error) {
+	qr, _, err := decodeGrading(grading.NewImage(img), opts)
+	return qr, err
+}
+
+// decodeGrading decodes the symbol in gimg, and then in the inverted gimg if the finder patterns are not found.
+// It also returns the bounds of the symbol in gimg, which exclude the quiet zone.
+// gimg may be modified during decoding.
+func decodeGrading(gimg *grading.Image, opts []DecodeOptions) (*QRCode, image.Rectangle, error) {
+	qr, bounds, err := decodeImage(gimg, opts)
+	if !errors.Is(err, errFinderNotFound) {
+		return qr, bounds, err
+	}
+
+	// light on dark symbol
+	gimg.Invert()
+	qr, bounds, err = decodeImage(gimg, opts)
+	if err != nil {
+		return nil, image.Rectangle{}, err
+	}
+	qr.Orientation.Inverted = true
+	return qr, bounds, nil
+}
+
+var errFinderNotFound = errors.New("qrcode: finder pattern not found")
+
+// decodeImage decodes the symbol in gimg, and returns it with its bounds fitted by the grid.
+func decodeImage(gimg *grading.Image, opts []DecodeOptions) (*QRCode, image.Rectangle, error) {
+	rect, _, err := gimg.Locate()
+	if err != nil {
+		return nil, image.Rectangle{}, errFinderNotFound
+	}
+	s := sampling.New(gimg)
+
+	// finder patterns at the corners
+	r := float64(min(rect.Dx(), rect.Dy())) / 4
+	maxArea := rect.Dx() * rect.Dy() / 4
+	var finders [3]finderPattern
+	for i, p := range []sampling.Point{
+		{X: float64(rect.Min.X), Y: float64(rect.Min.Y)},
+		{X: float64(rect.Max.X), Y: float64(rect.Min.Y)},
+		{X: float64(rect.Min.X), Y: float64(rect.Max.Y)},
+	} {
+		q, ok := s.Finder(p, r, maxArea)
+		if !ok {
+			return nil, image.Rectangle{}, errFinderNotFound
+		}
+		finders[i] = finderPattern(q)
+	}
+	size := (finders[0].pitch().X + finders[0].pitch().Y) / 2
+
+	// estimate the version in both directions, because the symbol may be compressed in one of them.
+	dx := finders[1].center().Sub(finders[0].center())
+	dy := finders[2].center().Sub(finders[0].center())
+	nx := math.Hypot(dx.X, dx.Y)/((finders[0].pitch().X+finders[1].pitch().X)/2) + 7
+	ny := math.Hypot(dy.X, dy.Y)/((finders[0].pitch().Y+finders[2].pitch().Y)/2) + 7
+	vx := Version(int(math.Round((nx - 17) / 4)))
+	vy := Version(int(math.Round((ny - 17) / 4)))
+
+	err = errors.New("qrcode: QRCode not found")
+	tried := make(map[Version]bool)
+	for _, v := range []Version{vx, vy, vx - 1, vy - 1, vx + 1, vy + 1} {
+		if v < 1 || v > 40 || tried[v] {
+			continue
+		}
+		tried[v] = true
+		g := fitGrid(s, finders, size, v)
+		qr, e := DecodeBitmap(s.Sample(g), opts...)
+		if e == nil {
+			return qr, g.Bounds(), nil
+		}
+		err = e
+	}
+	return nil, image.Rectangle{}, err
+}
+
+// finderPattern is the outer corners of a finder pattern found in the image.
+type finderPattern sampling.Quad
+
+func (f finderPattern) center() sampling.Point {
+	return sampling.Quad(f).Center()
+}
+
+// pitch returns the module pitches in the pattern.
+func (f finderPattern) pitch() sampling.Point {
+	return sampling.Quad(f).Size().Mul(1.0 / 7)
+}
+
+// at returns the center of the module (x, y) in the pattern.
+// The modules out of the pattern are extrapolated.
+func (f finderPattern) at(x, y int) sampling.Point {
+	return sampling.Quad(f).Map((float64(x)+0.5)/7, (float64(y)+0.5)/7)
+}
+
+// fitGrid fits the grid of version to the symbol with the finder patterns at the top left,
+// the top right and the bottom left corners.
+// size is the module size estimated from the finder patterns.
+func fitGrid(s *sampling.Sampler, finders [3]finderPattern, size float64, version Version) *sampling.Grid {
+	n := 17 + 4*int(version)
+	tl := finders[0].center()
+	u := finders[1].center().Sub(tl).Mul(1 / float64(n-7))
+	v := finders[2].center().Sub(tl).Mul(1 / float64(n-7))
+	affine := func(x, y int) sampling.Point {
+		return tl.Add(u.Mul(float64(x - 3))).Add(v.Mul(float64(y - 3)))
+	}
+
+	// the lattice is on the edges of the symbol, the edges of the finder patterns,
+	// and the alignment patterns.
+	xs := []int{0}
+	if version >= 2 {
+		xs = append(xs, alignmentPatternPositions[version]...)
+	} else {
+		xs = append(xs, 6, n-7)
+	}
+	xs = append(xs, n-1)
+	last := len(xs) - 1
+	g := sampling.NewGrid(n, n, xs, xs)
+	g.Fallback = affine
+
+	// the corners of the finder patterns
+	g.Set(0, 0, finders[0].at(0, 0))
+	g.Set(1, 0, finders[0].at(6, 0))
+	g.Set(0, 1, finders[0].at(0, 6))
+	g.Set(1, 1, finders[0].at(6, 6))
+	g.Set(last-1, 0, finders[1].at(0, 0))
+	g.Set(last, 0, finders[1].at(6, 0))
+	g.Set(last-1, 1, finders[1].at(0, 6))
+	g.Set(last, 1, finders[1].at(6, 6))
+	g.Set(0, last-1, finders[2].at(0, 0))
+	g.Set(1, last-1, finders[2].at(6, 0))
+	g.Set(0, last, finders[2].at(0, 6))
+	g.Set(1, last, finders[2].at(6, 6))
+
+	// alignment patterns
+	if version >= 2 {
+		for j := 1; j < last; j++ {
+			for i := 1; i < last; i++ {
+				if _, ok := g.At(i, j); ok {
+					continue
+				}
+				p, ok := g.Predict(i, j)
+				if !ok {
+					p = affine(xs[i], xs[j])
+				}
+				c, b, ok := s.Blob(p, 1.5*size, true, int(2.5*size*size))
+				if !ok || float64(b.Dx()) > 2*size || float64(b.Dy()) > 2*size {
+					continue
+				}
+				if math.Hypot(c.X-p.X, c.Y-p.Y) > 1.5*size {
+					continue
+				}
+				g.Set(i, j, c)
+			}
+		}
+	}
+	g.Fill()
+
+	// timing patterns
+	base := baseList[version]
+	ideal := func(x, y int) bool { return bool(base.BinaryAt(x, y)) }
+	s.TimingX(g, timingPatternOffset, 8, n-9, ideal)
+	s.TimingY(g, timingPatternOffset, 8, n-9, ideal)
+	return g
+}
@@ -0,0 +1,37 @@
+package qrcode
+
+import (
+	"bytes"
+	"image/png"
+	"testing"
+)
+
+func TestImage(t *testing.T) {
+	qr, err := New([]byte("https://github.com/shogo82148/qrcode"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := qr.EncodePaletted(WithModuleSize(3.5), WithWidth(150))
+	if err != nil {
+		t.Fatal(err)
+	}
+	img, err := qr.Image(WithModuleSize(3.5), WithWidth(150))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if img.Bounds() != want.Bounds() {
+		t.Fatalf("unexpected bounds: got %v, want %v", img.Bounds(), want.Bounds())
+	}
+	for y := want.Rect.Min.Y; y < want.Rect.Max.Y; y++ {
+		for x := want.Rect.Min.X; x < want.Rect.Max.X; x++ {
+			if got, want := img.ColorIndexAt(x, y), want.ColorIndexAt(x, y); got != want {
+				t.Fatalf("pixel (%d, %d): got %d, want %d", x, y, got, want)
+			}
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		t.Fatal(err)
+	}
+}
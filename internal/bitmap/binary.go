@@ -123,6 +123,99 @@ func (img *Image) Copy(from *Image) *Image {
 	return img
 }
 
+// Invert inverts the colors of img in place.
+func (img *Image) Invert() *Image {
+	for y := img.Rect.Min.Y; y < img.Rect.Max.Y; y++ {
+		for x := img.Rect.Min.X; x < img.Rect.Max.X; x++ {
+			img.XorBinary(x, y, Black)
+		}
+	}
+	return img
+}
+
+// Transpose returns a new image with the rows and the columns of img swapped.
+func (img *Image) Transpose() *Image {
+	r := img.Rect
+	dst := New(image.Rect(r.Min.Y, r.Min.X, r.Max.Y, r.Max.X))
+	for y := r.Min.Y; y < r.Max.Y; y++ {
+		for x := r.Min.X; x < r.Max.X; x++ {
+			dst.SetBinary(y, x, img.BinaryAt(x, y))
+		}
+	}
+	return dst
+}
+
+// Rotate returns a new image of img rotated clockwise by degrees,
+// which must be 0, 90, 180 or 270.
+func (img *Image) Rotate(degrees int) *Image {
+	r := img.Rect
+	w, h := r.Dx(), r.Dy()
+	var dst *Image
+	switch degrees {
+	case 0:
+		return img.Clone()
+	case 90, 270:
+		dst = New(image.Rect(0, 0, h, w))
+	case 180:
+		dst = New(image.Rect(0, 0, w, h))
+	default:
+		panic(fmt.Sprintf("bitmap: invalid rotation: %d", degrees))
+	}
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			c := img.BinaryAt(x+r.Min.X, y+r.Min.Y)
+			switch degrees {
+			case 90:
+				dst.SetBinary(h-1-y, x, c)
+			case 180:
+				dst.SetBinary(w-1-x, h-1-y, c)
+			case 270:
+				dst.SetBinary(y, w-1-x, c)
+			}
+		}
+	}
+	return dst
+}
+
+// SubFinderMismatches returns the number of the modules that differ from the finder sub pattern
+// of rMQR Code, whose top left corner is at (x, y).
+// The pattern has 5 x 5 modules of the dark ring, the light ring and the dark center.
+func (img *Image) SubFinderMismatches(x, y int) int {
+	var cnt int
+	for dy := 0; dy < 5; dy++ {
+		for dx := 0; dx < 5; dx++ {
+			d := max(abs(dx-2), abs(dy-2))
+			if bool(img.BinaryAt(x+dx, y+dy)) != (d != 1) {
+				cnt++
+			}
+		}
+	}
+	return cnt
+}
+
+// FinderMismatches returns the number of the modules that differ from the finder pattern
+// of 7 x 7 modules whose top left corner is at (x, y).
+func (img *Image) FinderMismatches(x, y int) int {
+	var cnt int
+	for dy := 0; dy < 7; dy++ {
+		for dx := 0; dx < 7; dx++ {
+			// the dark ring, the light ring and the dark 3 x 3 stone.
+			d := max(abs(dx-3), abs(dy-3))
+			if bool(img.BinaryAt(x+dx, y+dy)) != (d != 2) {
+				cnt++
+			}
+		}
+	}
+	return cnt
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
+
 // OnesCount returns the number of 1-pixels (black-pixels).
 func (img *Image) OnesCount() int {
 	var cnt int
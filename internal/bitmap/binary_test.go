@@ -79,3 +79,74 @@ func TestMask(t *testing.T) {
 		}
 	}
 }
+
+func TestInvert(t *testing.T) {
+	img := New(image.Rect(0, 0, 3, 2))
+	img.SetBinary(0, 0, Black)
+	img.Invert()
+
+	if got, want := img.OnesCount(), 5; got != want {
+		t.Errorf("got %d, want %d", got, want)
+	}
+	if img.BinaryAt(0, 0) != White {
+		t.Errorf("got %v, want %v", img.BinaryAt(0, 0), White)
+	}
+}
+
+func TestTranspose(t *testing.T) {
+	img := New(image.Rect(0, 0, 3, 2))
+	img.SetBinary(2, 1, Black)
+	got := img.Transpose()
+
+	if got.Rect != image.Rect(0, 0, 2, 3) {
+		t.Errorf("got %v, want %v", got.Rect, image.Rect(0, 0, 2, 3))
+	}
+	if got.BinaryAt(1, 2) != Black || got.OnesCount() != 1 {
+		t.Error("unexpected pixels")
+	}
+}
+
+func TestFinderMismatches(t *testing.T) {
+	img := New(image.Rect(0, 0, 7, 7))
+	for y := 0; y < 7; y++ {
+		for x := 0; x < 7; x++ {
+			if x == 0 || x == 6 || y == 0 || y == 6 || (x >= 2 && x <= 4 && y >= 2 && y <= 4) {
+				img.SetBinary(x, y, Black)
+			}
+		}
+	}
+	if got := img.FinderMismatches(0, 0); got != 0 {
+		t.Errorf("got %d, want 0", got)
+	}
+	img.Invert()
+	if got := img.FinderMismatches(0, 0); got != 49 {
+		t.Errorf("got %d, want 49", got)
+	}
+}
+
+func TestRotate(t *testing.T) {
+	// #..
+	// ...
+	img := New(image.Rect(0, 0, 3, 2))
+	img.SetBinary(0, 0, Black)
+
+	tests := []struct {
+		degrees int
+		bounds  image.Rectangle
+		x, y    int
+	}{
+		{0, image.Rect(0, 0, 3, 2), 0, 0},
+		{90, image.Rect(0, 0, 2, 3), 1, 0},
+		{180, image.Rect(0, 0, 3, 2), 2, 1},
+		{270, image.Rect(0, 0, 2, 3), 0, 2},
+	}
+	for _, tt := range tests {
+		got := img.Rotate(tt.degrees)
+		if got.Rect != tt.bounds {
+			t.Errorf("%d: got %v, want %v", tt.degrees, got.Rect, tt.bounds)
+		}
+		if got.BinaryAt(tt.x, tt.y) != Black || got.OnesCount() != 1 {
+			t.Errorf("%d: unexpected pixels", tt.degrees)
+		}
+	}
+}
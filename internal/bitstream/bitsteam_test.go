@@ -163,3 +163,66 @@ func TestWriteBits(t *testing.T) {
 		}
 	}
 }
+
+func TestWriteBit_ByteBoundary(t *testing.T) {
+	var buf Buffer
+	buf.WriteBitsLSB(0b1010, 4)
+	buf.WriteBitsLSB(0b1010, 4)
+	buf.WriteBit(1)
+	if got, want := buf.Bytes(), []byte{0b1010_1010, 0b1000_0000}; !bytes.Equal(got, want) {
+		t.Errorf("got %08b, want %08b", got, want)
+	}
+	if buf.Len() != 9 {
+		t.Errorf("got %d bits, want %d", buf.Len(), 9)
+	}
+}
+
+func TestWriteBitsLSB_ByteBoundary(t *testing.T) {
+	tests := []struct {
+		name  string
+		write func(buf *Buffer)
+		want  []byte
+		bits  int
+	}{
+		{
+			name: "byte",
+			write: func(buf *Buffer) {
+				buf.WriteBitsLSB(0b101, 3)
+				buf.WriteBitsLSB(0b01010, 5)
+				buf.WriteBitsLSB(0b1100_0011, 8)
+			},
+			want: []byte{0b1010_1010, 0b1100_0011},
+			bits: 16,
+		},
+		{
+			name: "bits",
+			write: func(buf *Buffer) {
+				buf.WriteBitsLSB(0b1, 1)
+				buf.WriteBitsLSB(0b0101010, 7)
+				buf.WriteBitsLSB(0b11, 2)
+			},
+			want: []byte{0b1010_1010, 0b1100_0000},
+			bits: 10,
+		},
+		{
+			name: "wide",
+			write: func(buf *Buffer) {
+				buf.WriteBitsLSB(0b1010, 4)
+				buf.WriteBitsLSB(0b1010, 4)
+				buf.WriteBitsLSB(0x0fff, 12)
+			},
+			want: []byte{0b1010_1010, 0xff, 0xf0},
+			bits: 20,
+		},
+	}
+	for _, tt := range tests {
+		var buf Buffer
+		tt.write(&buf)
+		if got := buf.Bytes(); !bytes.Equal(got, tt.want) {
+			t.Errorf("%s: got %08b, want %08b", tt.name, got, tt.want)
+		}
+		if got := buf.Len(); got != tt.bits {
+			t.Errorf("%s: got %d bits, want %d", tt.name, got, tt.bits)
+		}
+	}
+}
@@ -31,6 +31,11 @@ func (b *Buffer) Len() int {
 	return l
 }
 
+// Offset returns the number of bits that have been read.
+func (b *Buffer) Offset() int {
+	return b.offset*8 + b.read
+}
+
 // ReadBit reads one bit from b.
 func (b *Buffer) ReadBit() (uint8, error) {
 	if b.offset >= len(b.buf) {
@@ -157,7 +162,7 @@ func (b *Buffer) writeBitsLSB(bits uint8, n int) {
 		return
 	}
 	b.buf[len(b.buf)-1] |= bits << (8 - (b.wrote + n))
-	b.wrote += n
+	b.wrote = (b.wrote + n) % 8
 }
 
 func (b *Buffer) writeByte(bits uint8) {
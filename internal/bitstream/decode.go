@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"unicode/utf8"
 )
 
 func DecodeNumeric(buf *Buffer, data []byte) error {
@@ -104,3 +105,26 @@ func DecodeKanji(buf *Buffer, length int) ([]byte, error) {
 	}
 	return ret.Bytes(), nil
 }
+
+// DecodeKanjiLenient decodes length Kanji characters in the same way as DecodeKanji,
+// but the invalid codes, which are out of range or not assigned to any character,
+// are decoded as U+FFFD REPLACEMENT CHARACTER instead of an error.
+// It returns the number of the invalid codes.
+func DecodeKanjiLenient(buf *Buffer, length int) ([]byte, int, error) {
+	var ret bytes.Buffer
+	ret.Grow(length * 3)
+	var invalid int
+	for i := 0; i < length; i++ {
+		bits, err := buf.ReadBits(13)
+		if err != nil {
+			return nil, 0, err
+		}
+		if bits >= uint64(len(decode)) || (bits != 0 && decode[bits] == 0) {
+			ret.WriteRune(utf8.RuneError)
+			invalid++
+			continue
+		}
+		ret.WriteRune(rune(decode[bits]))
+	}
+	return ret.Bytes(), invalid, nil
+}
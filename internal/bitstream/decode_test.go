@@ -165,3 +165,18 @@ func TestDecodeKanji_Error(t *testing.T) {
 		}
 	}
 }
+
+func TestDecodeKanjiLenient(t *testing.T) {
+	// "点", an out of range code 0x1fff, and an unassigned code 0x0fff
+	buf := NewBuffer([]byte{0b01101100, 0b11111111, 0b11111111, 0b11111111, 0b11111000})
+	got, invalid, err := DecodeKanjiLenient(buf, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "点\ufffd\ufffd"; string(got) != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+	if invalid != 2 {
+		t.Errorf("got %d invalid codes, want %d", invalid, 2)
+	}
+}
@@ -102,6 +102,25 @@ func EncodeKanji(buf *Buffer, data []byte) error {
 	return nil
 }
 
+// ShiftJIS converts the characters in kanji mode into Shift JIS.
+func ShiftJIS(data []byte) ([]byte, error) {
+	ret := make([]byte, 0, len(data))
+	for _, r := range string(data) {
+		code, ok := encodeKanji(r)
+		if !ok {
+			return nil, fmt.Errorf("qrcode: invalid character in kanji mode: %x", r)
+		}
+		sjis := (code/0xc0)<<8 | code%0xc0
+		if sjis < 0x1f00 {
+			sjis += 0x8140
+		} else {
+			sjis += 0xc140
+		}
+		ret = append(ret, byte(sjis>>8), byte(sjis))
+	}
+	return ret, nil
+}
+
 func encodeKanji(r rune) (uint64, bool) {
 	var code int16
 	switch {
@@ -163,3 +163,30 @@ func TestEncodeKanji_Error(t *testing.T) {
 		}
 	}
 }
+
+func TestShiftJIS(t *testing.T) {
+	tests := []struct {
+		in   []byte
+		want []byte
+	}{
+		{
+			in:   []byte("点"),
+			want: []byte{0x93, 0x5f},
+		},
+		{
+			in:   []byte("茗"),
+			want: []byte{0xe4, 0xaa},
+		},
+	}
+
+	for i, tt := range tests {
+		got, err := ShiftJIS(tt.in)
+		if err != nil {
+			t.Errorf("%d: %v", i, err)
+			continue
+		}
+		if !bytes.Equal(tt.want, got) {
+			t.Errorf("%d: got %x, want %x", i, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,30 @@
+package bitstream
+
+// NumericBits returns the number of the bits of n digits in the numeric mode.
+func NumericBits(n int) int {
+	return 10*(n/3) + [3]int{0, 4, 7}[n%3]
+}
+
+// AlphanumericBits returns the number of the bits of n characters in the alphanumeric mode.
+func AlphanumericBits(n int) int {
+	return 11*(n/2) + 6*(n%2)
+}
+
+// BytesBits returns the number of the bits of n bytes in the byte mode.
+func BytesBits(n int) int {
+	return 8 * n
+}
+
+// KanjiBits returns the number of the bits of n characters in the Kanji mode.
+func KanjiBits(n int) int {
+	return 13 * n
+}
+
+// Fit returns the largest number of the characters up to n that fit in rest bits.
+// bits is the number of the bits of the characters, such as NumericBits.
+func Fit(n, rest int, bits func(int) int) int {
+	for n > 0 && bits(n) > rest {
+		n--
+	}
+	return n
+}
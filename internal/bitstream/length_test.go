@@ -0,0 +1,26 @@
+package bitstream
+
+import "testing"
+
+func TestFit(t *testing.T) {
+	tests := []struct {
+		n, rest int
+		bits    func(int) int
+		want    int
+	}{
+		{5, 100, NumericBits, 5},
+		{5, 16, NumericBits, 4},
+		{5, 13, NumericBits, 3},
+		{5, 9, NumericBits, 2},
+		{5, 16, AlphanumericBits, 2},
+		{5, 17, AlphanumericBits, 3},
+		{5, 15, BytesBits, 1},
+		{5, 25, KanjiBits, 1},
+		{5, -3, BytesBits, 0},
+	}
+	for i, tt := range tests {
+		if got := Fit(tt.n, tt.rest, tt.bits); got != tt.want {
+			t.Errorf("%d: got %d, want %d", i, got, tt.want)
+		}
+	}
+}
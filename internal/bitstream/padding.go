@@ -0,0 +1,37 @@
+package bitstream
+
+// padCodewords is the pad codewords that are placed alternately.
+var padCodewords = [2]byte{0b1110_1100, 0b0001_0001}
+
+// CheckPadding checks the bits of data from the bit offset start to end.
+// The standard padding is the zero bits to the byte boundary,
+// followed by the pad codewords 11101100 and 00010001 alternately.
+// If the last codeword is shorter than 8 bits, e.g. 4 bits in M1 and M3 Micro QR Code symbols,
+// it must be zero.
+//
+// It returns the bit offset of the first pad codeword, and whether the padding is standard.
+func CheckPadding(data []byte, start, end int) (int, bool) {
+	bit := func(i int) byte {
+		return (data[i/8] >> (7 - i%8)) & 1
+	}
+
+	ok := true
+	pad := min(end, (start+7)/8*8)
+	for i := start; i < pad; i++ {
+		if bit(i) != 0 {
+			ok = false
+		}
+	}
+	for i := pad; i < end; i++ {
+		k, j := (i-pad)/8, (i-pad)%8
+		want := byte(0)
+		if i-j+8 <= end {
+			// the codeword is full length.
+			want = (padCodewords[k%2] >> (7 - j)) & 1
+		}
+		if bit(i) != want {
+			ok = false
+		}
+	}
+	return pad, ok
+}
@@ -0,0 +1,33 @@
+package bitstream
+
+import "testing"
+
+func TestCheckPadding(t *testing.T) {
+	tests := []struct {
+		data  []byte
+		start int
+		end   int
+		pad   int
+		ok    bool
+	}{
+		{[]byte{0x10, 0xec, 0x11, 0xec}, 4, 32, 8, true},
+		{[]byte{0x10, 0xec, 0x11, 0xec}, 8, 32, 8, true},
+		{[]byte{0x18, 0xec, 0x11, 0xec}, 4, 32, 8, false},
+		{[]byte{0x10, 0xec, 0x11, 0x11}, 4, 32, 8, false},
+		{[]byte{0x10, 0xec, 0x00, 0x00}, 4, 32, 8, false},
+
+		// the last codeword is 4 bits
+		{[]byte{0x10, 0xec, 0x00}, 4, 20, 8, true},
+		{[]byte{0x10, 0xec, 0xe0}, 4, 20, 8, false},
+
+		// no padding
+		{[]byte{0x10}, 8, 8, 8, true},
+		{[]byte{0x10}, 6, 8, 8, true},
+	}
+	for i, tt := range tests {
+		pad, ok := CheckPadding(tt.data, tt.start, tt.end)
+		if pad != tt.pad || ok != tt.ok {
+			t.Errorf("%d: got (%d, %t), want (%d, %t)", i, pad, ok, tt.pad, tt.ok)
+		}
+	}
+}
@@ -0,0 +1,117 @@
+package bitstream
+
+import (
+	"strconv"
+	"strings"
+)
+
+// DecodeMode is how strictly the segments are checked.
+// The values are the same as the DecodeMode of the public packages.
+type DecodeMode int
+
+const (
+	DecodeModeDefault DecodeMode = iota
+	DecodeModeStrict
+	DecodeModeLenient
+)
+
+// Violation is a rule of the specification that a symbol breaks.
+// The values are the same as the Violation of the public packages.
+type Violation int
+
+const (
+	ViolationFormat Violation = iota + 1
+	ViolationCharacterCount
+	ViolationKanji
+	ViolationTerminator
+	ViolationPadding
+	ViolationMode
+)
+
+func (v Violation) String() string {
+	switch v {
+	case ViolationFormat:
+		return "format"
+	case ViolationCharacterCount:
+		return "character count"
+	case ViolationKanji:
+		return "kanji"
+	case ViolationTerminator:
+		return "terminator"
+	case ViolationPadding:
+		return "padding"
+	case ViolationMode:
+		return "mode"
+	}
+	return "invalid(" + strconv.Itoa(int(v)) + ")"
+}
+
+// JoinViolations returns the comma separated names of the violations.
+func JoinViolations[V ~int](violations []V) string {
+	names := make([]string, len(violations))
+	for i, v := range violations {
+		names[i] = Violation(v).String()
+	}
+	return strings.Join(names, ", ")
+}
+
+// SegmentReader reads the segments from the data bit stream under the decode mode.
+type SegmentReader struct {
+	Buf      *Buffer
+	DataBits int
+	Mode     DecodeMode
+
+	// Violate records the violation v.
+	// The error returned stops decoding, typically in the strict mode.
+	Violate func(v Violation) error
+
+	// End is the bit offset of the end of the last segment.
+	End int
+
+	// Truncated reports whether the last segment is truncated in the lenient mode.
+	Truncated bool
+
+	// UnknownMode reports whether the stream has an unknown mode indicator.
+	UnknownMode bool
+}
+
+// Length reads the character count indicator of n bits.
+// bits is the number of the bits of the characters.
+// If the characters overflow the data bits, they are truncated in the lenient mode.
+func (r *SegmentReader) Length(n int, bits func(int) int) (int, error) {
+	v, err := r.Buf.ReadBits(n)
+	if err != nil {
+		return 0, err
+	}
+	length := int(v)
+	rest := r.DataBits - r.Buf.Offset()
+	if bits(length) <= rest {
+		return length, nil
+	}
+	if err := r.Violate(ViolationCharacterCount); err != nil {
+		return 0, err
+	}
+	if r.Mode == DecodeModeLenient {
+		length = Fit(length, rest, bits)
+		r.Truncated = true
+	}
+	return length, nil
+}
+
+// Kanji reads length Kanji characters.
+// The invalid codes are replaced only in the lenient mode.
+func (r *SegmentReader) Kanji(length int) ([]byte, error) {
+	if r.Mode == DecodeModeDefault {
+		return DecodeKanji(r.Buf, length)
+	}
+	data, invalid, err := DecodeKanjiLenient(r.Buf, length)
+	if err != nil {
+		return nil, err
+	}
+	if invalid > 0 {
+		if err := r.Violate(ViolationKanji); err != nil {
+			return nil, err
+		}
+	}
+	return data, nil
+}
@@ -0,0 +1,88 @@
+package bitstream
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestSegmentReader_Length(t *testing.T) {
+	errStrict := errors.New("strict")
+	tests := []struct {
+		mode       DecodeMode
+		want       int
+		truncated  bool
+		err        error
+		violations []Violation
+	}{
+		{DecodeModeDefault, 5, false, nil, []Violation{ViolationCharacterCount}},
+		{DecodeModeStrict, 0, false, errStrict, []Violation{ViolationCharacterCount}},
+		{DecodeModeLenient, 3, true, nil, []Violation{ViolationCharacterCount}},
+	}
+	for _, tt := range tests {
+		var violations []Violation
+		r := &SegmentReader{
+			// the count 5 in 4 bits, followed by 12 bits for the digits.
+			Buf:      NewBuffer([]byte{0b01010000, 0b00000000}),
+			DataBits: 16,
+			Mode:     tt.mode,
+			Violate: func(v Violation) error {
+				violations = append(violations, v)
+				if tt.mode == DecodeModeStrict {
+					return errStrict
+				}
+				return nil
+			},
+		}
+		got, err := r.Length(4, NumericBits)
+		if err != tt.err {
+			t.Errorf("mode %d: got error %v, want %v", tt.mode, err, tt.err)
+		}
+		if got != tt.want {
+			t.Errorf("mode %d: got %d, want %d", tt.mode, got, tt.want)
+		}
+		if r.Truncated != tt.truncated {
+			t.Errorf("mode %d: got truncated %t, want %t", tt.mode, r.Truncated, tt.truncated)
+		}
+		if !reflect.DeepEqual(violations, tt.violations) {
+			t.Errorf("mode %d: got violations %v, want %v", tt.mode, violations, tt.violations)
+		}
+	}
+}
+
+func TestSegmentReader_Kanji(t *testing.T) {
+	// "点" and an out of range code 0x1fff
+	in := []byte{0b01101100, 0b11111111, 0b11111111, 0b11000000}
+
+	r := &SegmentReader{Buf: NewBuffer(in), Mode: DecodeModeDefault}
+	if _, err := r.Kanji(2); err == nil {
+		t.Error("default mode: want error, but not")
+	}
+
+	var violations []Violation
+	r = &SegmentReader{
+		Buf:  NewBuffer(in),
+		Mode: DecodeModeLenient,
+		Violate: func(v Violation) error {
+			violations = append(violations, v)
+			return nil
+		},
+	}
+	got, err := r.Kanji(2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "点\ufffd"; string(got) != want {
+		t.Errorf("lenient mode: got %q, want %q", got, want)
+	}
+	if want := []Violation{ViolationKanji}; !reflect.DeepEqual(violations, want) {
+		t.Errorf("lenient mode: got violations %v, want %v", violations, want)
+	}
+}
+
+func TestJoinViolations(t *testing.T) {
+	got := JoinViolations([]Violation{ViolationMode, ViolationPadding, 0})
+	if want := "mode, padding, invalid(0)"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
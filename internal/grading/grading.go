@@ -0,0 +1,115 @@
+// Package grading implements the print quality grading of ISO/IEC 15415 and ISO/IEC 29158,
+// which is shared by QR Code, Micro QR Code and rMQR Code.
+package grading
+
+// The grades. They are the numeric grades of ISO/IEC 15415,
+// and A is the best.
+const (
+	F = iota
+	D
+	C
+	B
+	A
+)
+
+// gradeAbove returns the grade of v, where the larger v is better.
+// thresholds is the lower limits of the grades A, B, C and D.
+func gradeAbove(v float64, thresholds [4]float64) int {
+	for i, t := range thresholds {
+		if v >= t {
+			return A - i
+		}
+	}
+	return F
+}
+
+// gradeBelow returns the grade of v, where the smaller v is better.
+// thresholds is the upper limits of the grades A, B, C and D.
+func gradeBelow(v float64, thresholds [4]float64) int {
+	for i, t := range thresholds {
+		if v <= t {
+			return A - i
+		}
+	}
+	return F
+}
+
+// SymbolContrast returns the grade of the symbol contrast sc.
+func SymbolContrast(sc float64) int {
+	return gradeAbove(sc, [4]float64{0.70, 0.55, 0.40, 0.20})
+}
+
+// MinReflectance returns the grade of the minimum reflectance rmin.
+// It must be at most the half of the maximum reflectance rmax.
+func MinReflectance(rmin, rmax float64) int {
+	if rmin <= 0.5*rmax {
+		return A
+	}
+	return F
+}
+
+// Modulation returns the grade of the modulation or the reflectance margin of a module.
+func Modulation(mod float64) int {
+	return gradeAbove(mod, [4]float64{0.50, 0.40, 0.30, 0.20})
+}
+
+// AxialNonuniformity returns the grade of the axial non-uniformity an.
+func AxialNonuniformity(an float64) int {
+	return gradeBelow(an, [4]float64{0.06, 0.08, 0.10, 0.12})
+}
+
+// GridNonuniformity returns the grade of the grid non-uniformity gn,
+// which is measured in modules.
+func GridNonuniformity(gn float64) int {
+	return gradeBelow(gn, [4]float64{0.38, 0.50, 0.63, 0.75})
+}
+
+// UnusedErrorCorrection returns the grade of the unused error correction uec.
+func UnusedErrorCorrection(uec float64) int {
+	return gradeAbove(uec, [4]float64{0.62, 0.50, 0.37, 0.25})
+}
+
+// FixedPatternDamage returns the grade of a segment of the fixed patterns
+// that has errors damaged modules.
+func FixedPatternDamage(errors int) int {
+	return max(A-errors, F)
+}
+
+// Unused returns the unused error correction of a block
+// that has errors codeword errors and can correct maxError codeword errors.
+func Unused(errors, maxError int) float64 {
+	if errors <= 0 {
+		return 1
+	}
+	if errors > maxError {
+		return 0
+	}
+	return 1 - float64(errors)/float64(maxError)
+}
+
+// ErrorCorrection returns the grade of the modulation or the reflectance margin,
+// taking the error correction into account.
+// codewords is the grades of the codewords in each block,
+// which are the lowest grades of their modules.
+// maxErrors is the number of codeword errors that each block can correct.
+//
+// For each grade level, the codewords below the level are regarded as errors,
+// and the level is limited by the grade of the unused error correction.
+// The result is the best of them.
+func ErrorCorrection(codewords [][]int, maxErrors []int) int {
+	grade := F
+	for level := A; level > F; level-- {
+		uec := 1.0
+		for i, blk := range codewords {
+			var errors int
+			for _, g := range blk {
+				if g < level {
+					errors++
+				}
+			}
+			uec = min(uec, Unused(errors, maxErrors[i]))
+		}
+		grade = max(grade, min(level, UnusedErrorCorrection(uec)))
+	}
+	return grade
+}
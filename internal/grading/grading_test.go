@@ -0,0 +1,85 @@
+package grading
+
+import "testing"
+
+func TestSymbolContrast(t *testing.T) {
+	tests := []struct {
+		sc   float64
+		want int
+	}{
+		{1, A},
+		{0.70, A},
+		{0.69, B},
+		{0.55, B},
+		{0.40, C},
+		{0.20, D},
+		{0.19, F},
+	}
+	for _, tt := range tests {
+		if got := SymbolContrast(tt.sc); got != tt.want {
+			t.Errorf("SymbolContrast(%v): got %v, want %v", tt.sc, got, tt.want)
+		}
+	}
+}
+
+func TestGridNonuniformity(t *testing.T) {
+	tests := []struct {
+		gn   float64
+		want int
+	}{
+		{0, A},
+		{0.38, A},
+		{0.39, B},
+		{0.63, C},
+		{0.75, D},
+		{0.76, F},
+	}
+	for _, tt := range tests {
+		if got := GridNonuniformity(tt.gn); got != tt.want {
+			t.Errorf("GridNonuniformity(%v): got %v, want %v", tt.gn, got, tt.want)
+		}
+	}
+}
+
+func TestErrorCorrection(t *testing.T) {
+	tests := []struct {
+		codewords [][]int
+		maxErrors []int
+		want      int
+	}{
+		{
+			codewords: [][]int{{A, A, A, A}},
+			maxErrors: []int{2},
+			want:      A,
+		},
+		{
+			// a codeword of grade B is regarded as an error at the level A,
+			// and the unused error correction 0.5 limits the level A to B.
+			codewords: [][]int{{A, B, A, A}},
+			maxErrors: []int{2},
+			want:      B,
+		},
+		{
+			// a codeword of grade F is an error at all levels.
+			codewords: [][]int{{A, F, A, A}},
+			maxErrors: []int{2},
+			want:      B,
+		},
+		{
+			codewords: [][]int{{A, F, F, A}},
+			maxErrors: []int{2},
+			want:      F,
+		},
+		{
+			// the block can't correct any errors.
+			codewords: [][]int{{A, A}, {A, C}},
+			maxErrors: []int{4, 0},
+			want:      C,
+		},
+	}
+	for i, tt := range tests {
+		if got := ErrorCorrection(tt.codewords, tt.maxErrors); got != tt.want {
+			t.Errorf("%d: got %v, want %v", i, got, tt.want)
+		}
+	}
+}
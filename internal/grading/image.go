@@ -0,0 +1,388 @@
+package grading
+
+import (
+	"errors"
+	"image"
+	"image/color"
+	"math"
+	"slices"
+
+	"github.com/shogo82148/go-imaging/bitmap"
+)
+
+// Image is the reflectance of a captured image, from 0 (black) to 1 (white).
+type Image struct {
+	Rect   image.Rectangle
+	Pix    []float64
+	Stride int
+}
+
+// NewImage converts img into the reflectance.
+func NewImage(img image.Image) *Image {
+	bounds := img.Bounds()
+	ret := &Image{
+		Rect:   bounds,
+		Pix:    make([]float64, bounds.Dx()*bounds.Dy()),
+		Stride: bounds.Dx(),
+	}
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			c := color.Gray16Model.Convert(img.At(x, y)).(color.Gray16)
+			ret.Pix[ret.offset(x, y)] = float64(c.Y) / 0xffff
+		}
+	}
+	return ret
+}
+
+func (img *Image) offset(x, y int) int {
+	return (y-img.Rect.Min.Y)*img.Stride + (x - img.Rect.Min.X)
+}
+
+// At returns the reflectance of the pixel at (x, y).
+// The pixels outside of the image are the nearest pixels on the border.
+func (img *Image) At(x, y int) float64 {
+	x = min(max(x, img.Rect.Min.X), img.Rect.Max.X-1)
+	y = min(max(y, img.Rect.Min.Y), img.Rect.Max.Y-1)
+	return img.Pix[img.offset(x, y)]
+}
+
+// Invert inverts the reflectance of img in place.
+func (img *Image) Invert() {
+	for i, v := range img.Pix {
+		img.Pix[i] = 1 - v
+	}
+}
+
+// Fill sets the reflectance of the pixels of img in r to v.
+func (img *Image) Fill(r image.Rectangle, v float64) {
+	r = r.Intersect(img.Rect)
+	for y := r.Min.Y; y < r.Max.Y; y++ {
+		for x := r.Min.X; x < r.Max.X; x++ {
+			img.Pix[img.offset(x, y)] = v
+		}
+	}
+}
+
+// Transpose returns a new image with the rows and the columns of img swapped.
+func (img *Image) Transpose() *Image {
+	r := img.Rect
+	ret := &Image{
+		Rect:   image.Rect(r.Min.Y, r.Min.X, r.Max.Y, r.Max.X),
+		Pix:    make([]float64, len(img.Pix)),
+		Stride: r.Dy(),
+	}
+	for y := r.Min.Y; y < r.Max.Y; y++ {
+		for x := r.Min.X; x < r.Max.X; x++ {
+			ret.Pix[ret.offset(y, x)] = img.Pix[img.offset(x, y)]
+		}
+	}
+	return ret
+}
+
+// threshold returns the midpoint of the minimum and the maximum reflectance of img.
+// ok is false if img has no contrast.
+func (img *Image) threshold() (float64, bool) {
+	if img.Rect.Empty() {
+		return 0, false
+	}
+	lo, hi := img.Pix[0], img.Pix[0]
+	for _, v := range img.Pix {
+		lo = min(lo, v)
+		hi = max(hi, v)
+	}
+	if hi-lo < 0.05 {
+		return 0, false
+	}
+	return (lo + hi) / 2, true
+}
+
+// LightOnDark reports whether the top left pixel of img is dark,
+// which means that the quiet zone is dark and the symbol is light on dark.
+func (img *Image) LightOnDark() bool {
+	threshold, ok := img.threshold()
+	return ok && img.At(img.Rect.Min.X, img.Rect.Min.Y) < threshold
+}
+
+// Locate finds the bounds of the dark pixels,
+// and the lengths of the dark runs on their top and left edges,
+// which are the top and left borders of the finder pattern.
+func (img *Image) Locate() (image.Rectangle, image.Point, error) {
+	threshold, ok := img.threshold()
+	if !ok {
+		return image.Rectangle{}, image.Point{}, errors.New("grading: symbol not found")
+	}
+
+	r := image.Rectangle{}
+	found := false
+	for y := img.Rect.Min.Y; y < img.Rect.Max.Y; y++ {
+		for x := img.Rect.Min.X; x < img.Rect.Max.X; x++ {
+			if img.At(x, y) >= threshold {
+				continue
+			}
+			pt := image.Rect(x, y, x+1, y+1)
+			if !found {
+				r = pt
+				found = true
+			} else {
+				r = r.Union(pt)
+			}
+		}
+	}
+
+	var run image.Point
+	x := r.Min.X
+	for x < r.Max.X && img.At(x, r.Min.Y) >= threshold {
+		x++
+	}
+	for ; x < r.Max.X && img.At(x, r.Min.Y) < threshold; x++ {
+		run.X++
+	}
+	y := r.Min.Y
+	for y < r.Max.Y && img.At(r.Min.X, y) >= threshold {
+		y++
+	}
+	for ; y < r.Max.Y && img.At(r.Min.X, y) < threshold; y++ {
+		run.Y++
+	}
+	return r, run, nil
+}
+
+// Corners finds the bounds of the symbol in img, which may be light on dark,
+// and the runs of the symbol color from the corners of the bounds.
+// If [Image.LightOnDark] reports true, the light pixels are the symbol color.
+// runs are in the clockwise order from the top left corner,
+// and each of them has the length along the horizontal edge in X and along the vertical edge in Y.
+// The finder patterns are at some of the corners, where the runs are their borders.
+func (img *Image) Corners() (image.Rectangle, [4]image.Point, error) {
+	src := img
+	if img.LightOnDark() {
+		src = &Image{Rect: img.Rect, Pix: slices.Clone(img.Pix), Stride: img.Stride}
+		src.Invert()
+	}
+	r, _, err := src.Locate()
+	if err != nil {
+		return image.Rectangle{}, [4]image.Point{}, err
+	}
+	threshold, _ := src.threshold()
+
+	// run counts the dark pixels from (x, y) in the direction (dx, dy) within r,
+	// skipping the light pixels at the start.
+	run := func(x, y, dx, dy int) int {
+		p := image.Pt(x, y)
+		d := image.Pt(dx, dy)
+		for p.In(r) && src.At(p.X, p.Y) >= threshold {
+			p = p.Add(d)
+		}
+		n := 0
+		for ; p.In(r) && src.At(p.X, p.Y) < threshold; p = p.Add(d) {
+			n++
+		}
+		return n
+	}
+	x0, y0, x1, y1 := r.Min.X, r.Min.Y, r.Max.X-1, r.Max.Y-1
+	runs := [4]image.Point{
+		{run(x0, y0, 1, 0), run(x0, y0, 0, 1)},
+		{run(x1, y0, -1, 0), run(x1, y0, 0, 1)},
+		{run(x1, y1, -1, 0), run(x1, y1, 0, -1)},
+		{run(x0, y1, 1, 0), run(x0, y1, 0, -1)},
+	}
+	return r, runs, nil
+}
+
+// Grid is the sampling grid of the modules.
+type Grid struct {
+	// Rect is the bounds of the symbol in the image.
+	Rect image.Rectangle
+
+	// Cols and Rows are the numbers of the modules.
+	Cols, Rows int
+}
+
+// Pitch returns the module pitches in pixels.
+func (g Grid) Pitch() (float64, float64) {
+	return float64(g.Rect.Dx()) / float64(g.Cols), float64(g.Rect.Dy()) / float64(g.Rows)
+}
+
+// Center returns the center of the module at (x, y) in the image.
+func (g Grid) Center(x, y int) (float64, float64) {
+	px, py := g.Pitch()
+	return float64(g.Rect.Min.X) + (float64(x)+0.5)*px, float64(g.Rect.Min.Y) + (float64(y)+0.5)*py
+}
+
+// Measurement is the reflectance of the modules sampled on a grid.
+type Measurement struct {
+	Image *Image
+	Grid  Grid
+
+	// Modules is the reflectance of the modules in the row-major order.
+	Modules []float64
+
+	// Rmin and Rmax are the minimum and the maximum reflectance
+	// of the modules and the quiet zone next to the symbol.
+	Rmin, Rmax float64
+
+	// Threshold is the global threshold between the dark and the light modules.
+	Threshold float64
+}
+
+// Measure samples the modules of img on g.
+// The reflectance of a module is the average in the aperture of 0.8 modules in diameter.
+func Measure(img *Image, g Grid) *Measurement {
+	m := &Measurement{
+		Image:   img,
+		Grid:    g,
+		Modules: make([]float64, g.Cols*g.Rows),
+		Rmin:    math.Inf(1),
+		Rmax:    math.Inf(-1),
+	}
+	for y := -1; y <= g.Rows; y++ {
+		for x := -1; x <= g.Cols; x++ {
+			cx, cy := g.Center(x, y)
+			if !image.Pt(int(math.Floor(cx)), int(math.Floor(cy))).In(img.Rect) {
+				continue
+			}
+			v := m.sample(cx, cy)
+			m.Rmin = min(m.Rmin, v)
+			m.Rmax = max(m.Rmax, v)
+			if x >= 0 && x < g.Cols && y >= 0 && y < g.Rows {
+				m.Modules[y*g.Cols+x] = v
+			}
+		}
+	}
+	m.Threshold = (m.Rmin + m.Rmax) / 2
+	return m
+}
+
+func (m *Measurement) sample(cx, cy float64) float64 {
+	px, py := m.Grid.Pitch()
+	r := 0.4 * min(px, py)
+	var sum float64
+	var n int
+	for y := int(math.Floor(cy - r)); y <= int(math.Ceil(cy+r)); y++ {
+		for x := int(math.Floor(cx - r)); x <= int(math.Ceil(cx+r)); x++ {
+			dx, dy := float64(x)+0.5-cx, float64(y)+0.5-cy
+			if dx*dx+dy*dy > r*r {
+				continue
+			}
+			sum += m.Image.At(x, y)
+			n++
+		}
+	}
+	if n == 0 {
+		return m.Image.At(int(math.Floor(cx)), int(math.Floor(cy)))
+	}
+	return sum / float64(n)
+}
+
+// At returns the reflectance of the module at (x, y).
+func (m *Measurement) At(x, y int) float64 {
+	return m.Modules[y*m.Grid.Cols+x]
+}
+
+// SymbolContrast returns the symbol contrast.
+func (m *Measurement) SymbolContrast() float64 {
+	return m.Rmax - m.Rmin
+}
+
+// Dark reports whether the module at (x, y) is dark.
+func (m *Measurement) Dark(x, y int) bool {
+	return m.At(x, y) < m.Threshold
+}
+
+// Modulation returns the modulation of the module at (x, y).
+func (m *Measurement) Modulation(x, y int) float64 {
+	sc := m.SymbolContrast()
+	if sc <= 0 {
+		return 0
+	}
+	return 2 * math.Abs(m.At(x, y)-m.Threshold) / sc
+}
+
+// Margin returns the reflectance margin of the module at (x, y),
+// which should be dark if dark is true.
+// It is negative if the module is on the wrong side of the threshold.
+func (m *Measurement) Margin(x, y int, dark bool) float64 {
+	sc := m.SymbolContrast()
+	if sc <= 0 {
+		return 0
+	}
+	d := m.At(x, y) - m.Threshold
+	if dark {
+		d = -d
+	}
+	return 2 * d / sc
+}
+
+// Bitmap returns the modules binarized by the global threshold.
+func (m *Measurement) Bitmap() *bitmap.Image {
+	img := bitmap.New(image.Rect(0, 0, m.Grid.Cols, m.Grid.Rows))
+	for y := 0; y < m.Grid.Rows; y++ {
+		for x := 0; x < m.Grid.Cols; x++ {
+			img.SetBinary(x, y, bitmap.Color(m.Dark(x, y)))
+		}
+	}
+	return img
+}
+
+// AxialNonuniformity returns the axial non-uniformity of the grid.
+func (m *Measurement) AxialNonuniformity() float64 {
+	px, py := m.Grid.Pitch()
+	return math.Abs(px-py) / ((px + py) / 2)
+}
+
+// GridNonuniformity returns the largest deviation of the module centers from the grid in modules.
+// The centers are measured on the edges of the isolated function modules,
+// such as the timing patterns.
+// dark reports whether the module should be dark, and function reports whether it is a function module.
+func (m *Measurement) GridNonuniformity(dark, function func(x, y int) bool) float64 {
+	px, py := m.Grid.Pitch()
+	isolated := func(x, y, dx, dy int) bool {
+		x0, y0, x1, y1 := x-dx, y-dy, x+dx, y+dy
+		if x0 < 0 || y0 < 0 || x1 >= m.Grid.Cols || y1 >= m.Grid.Rows {
+			return false
+		}
+		if !function(x0, y0) || !function(x, y) || !function(x1, y1) {
+			return false
+		}
+		c := dark(x, y)
+		return dark(x0, y0) != c && dark(x1, y1) != c
+	}
+
+	var gn float64
+	for y := 0; y < m.Grid.Rows; y++ {
+		for x := 0; x < m.Grid.Cols; x++ {
+			cx, cy := m.Grid.Center(x, y)
+			if isolated(x, y, 1, 0) {
+				row := int(math.Floor(cy))
+				left := m.edge(cx-px/2, px, func(i int) float64 { return m.Image.At(i, row) })
+				right := m.edge(cx+px/2, px, func(i int) float64 { return m.Image.At(i, row) })
+				gn = max(gn, math.Abs((left+right)/2-cx)/px)
+			}
+			if isolated(x, y, 0, 1) {
+				col := int(math.Floor(cx))
+				top := m.edge(cy-py/2, py, func(i int) float64 { return m.Image.At(col, i) })
+				bottom := m.edge(cy+py/2, py, func(i int) float64 { return m.Image.At(col, i) })
+				gn = max(gn, math.Abs((top+bottom)/2-cy)/py)
+			}
+		}
+	}
+	return gn
+}
+
+// edge finds the crossing of the threshold nearest to pos within half of the pitch.
+// at returns the reflectance of the i-th pixel along the scan line.
+// If there is no crossing, pos shifted by half of the pitch is returned.
+func (m *Measurement) edge(pos, pitch float64, at func(i int) float64) float64 {
+	best := pos + pitch/2
+	for i := int(math.Floor(pos - pitch/2)); i <= int(math.Ceil(pos+pitch/2)); i++ {
+		v0, v1 := at(i)-m.Threshold, at(i+1)-m.Threshold
+		if (v0 < 0) == (v1 < 0) {
+			continue
+		}
+		p := float64(i) + 0.5 + v0/(v0-v1)
+		if math.Abs(p-pos) < math.Abs(best-pos) {
+			best = p
+		}
+	}
+	return best
+}
@@ -0,0 +1,135 @@
+package grading
+
+import (
+	"image"
+	"math"
+	"testing"
+)
+
+// newImage renders the modules, where '#' is dark, with the module size sx x sy pixels
+// and the quiet zone of 2 modules.
+func newImage(modules []string, sx, sy int, dark, light float64) *Image {
+	w, h := len(modules[0])+4, len(modules)+4
+	img := &Image{
+		Rect:   image.Rect(0, 0, w*sx, h*sy),
+		Pix:    make([]float64, w*sx*h*sy),
+		Stride: w * sx,
+	}
+	for y := 0; y < h*sy; y++ {
+		for x := 0; x < w*sx; x++ {
+			v := light
+			mx, my := x/sx-2, y/sy-2
+			if my >= 0 && my < len(modules) && mx >= 0 && mx < len(modules[my]) && modules[my][mx] == '#' {
+				v = dark
+			}
+			img.Pix[img.offset(x, y)] = v
+		}
+	}
+	return img
+}
+
+// symbol has the finder patterns of 3 x 3 modules at the top left, the top right and the bottom left corners.
+var symbol = []string{
+	"###.###",
+	"#.#.#.#",
+	"###.###",
+	".......",
+	"###.#..",
+	"#.#..#.",
+	"###.#.#",
+}
+
+func TestImage_Corners(t *testing.T) {
+	for _, inverted := range []bool{false, true} {
+		dark, light := 0.0, 1.0
+		if inverted {
+			dark, light = light, dark
+		}
+		img := newImage(symbol, 4, 4, dark, light)
+		if got := img.LightOnDark(); got != inverted {
+			t.Errorf("inverted %t: got LightOnDark %t", inverted, got)
+		}
+		rect, runs, err := img.Corners()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := image.Rect(8, 8, 36, 36); rect != want {
+			t.Errorf("inverted %t: got %v, want %v", inverted, rect, want)
+		}
+		want := [4]image.Point{{12, 12}, {12, 12}, {4, 4}, {12, 12}}
+		if runs != want {
+			t.Errorf("inverted %t: got %v, want %v", inverted, runs, want)
+		}
+	}
+}
+
+func TestMeasure(t *testing.T) {
+	// the modules are 11 x 10 pixels, the dark modules are 0.3, and the light modules are 0.8.
+	img := newImage(symbol, 11, 10, 0.3, 0.8)
+	m := Measure(img, Grid{Rect: image.Rect(22, 20, 99, 90), Cols: 7, Rows: 7})
+
+	if math.Abs(m.Rmin-0.3) > 1e-9 || math.Abs(m.Rmax-0.8) > 1e-9 {
+		t.Errorf("got Rmin %v and Rmax %v, want 0.3 and 0.8", m.Rmin, m.Rmax)
+	}
+	if got := SymbolContrast(m.SymbolContrast()); got != C {
+		t.Errorf("got symbol contrast %v, want %v", got, C)
+	}
+	if got := MinReflectance(m.Rmin, m.Rmax); got != A {
+		t.Errorf("got minimum reflectance %v, want %v", got, A)
+	}
+	if got := AxialNonuniformity(m.AxialNonuniformity()); got != C {
+		t.Errorf("got axial non-uniformity %v (%v), want %v", got, m.AxialNonuniformity(), C)
+	}
+	for y, row := range symbol {
+		for x, c := range row {
+			if m.Dark(x, y) != (c == '#') {
+				t.Errorf("module (%d, %d): got dark %t", x, y, m.Dark(x, y))
+			}
+			if got := m.Modulation(x, y); math.Abs(got-1) > 1e-9 {
+				t.Errorf("module (%d, %d): got modulation %v, want 1", x, y, got)
+			}
+			if got := m.Margin(x, y, c != '#'); math.Abs(got+1) > 1e-9 {
+				t.Errorf("module (%d, %d): got margin %v of the wrong color, want -1", x, y, got)
+			}
+		}
+	}
+
+	// the module centers of the rendered symbol are on the grid.
+	m = Measure(newImage(symbol, 8, 8, 0, 1), Grid{Rect: image.Rect(16, 16, 72, 72), Cols: 7, Rows: 7})
+	dark := func(x, y int) bool { return symbol[y][x] == '#' }
+	function := func(x, y int) bool { return true }
+	if got := m.GridNonuniformity(dark, function); got != 0 {
+		t.Errorf("got grid non-uniformity %v, want 0", got)
+	}
+	if got := m.SymbolContrast(); got != 1 {
+		t.Errorf("got symbol contrast %v, want 1", got)
+	}
+
+	// the minimum reflectance is too high.
+	m = Measure(newImage(symbol, 8, 8, 0.6, 1), Grid{Rect: image.Rect(16, 16, 72, 72), Cols: 7, Rows: 7})
+	if got := MinReflectance(m.Rmin, m.Rmax); got != F {
+		t.Errorf("got minimum reflectance %v, want %v", got, F)
+	}
+}
+
+func TestNewImage(t *testing.T) {
+	src := image.NewGray(image.Rect(2, 3, 4, 4))
+	src.Pix[0], src.Pix[1] = 0x00, 0xff
+	img := NewImage(src)
+	if img.Rect != src.Rect {
+		t.Errorf("got %v, want %v", img.Rect, src.Rect)
+	}
+	if img.At(2, 3) != 0 || img.At(3, 3) != 1 {
+		t.Errorf("got reflectance %v and %v, want 0 and 1", img.At(2, 3), img.At(3, 3))
+	}
+
+	// the pixels outside are the nearest ones.
+	if img.At(0, 0) != 0 || img.At(10, 10) != 1 {
+		t.Errorf("got reflectance %v and %v outside, want 0 and 1", img.At(0, 0), img.At(10, 10))
+	}
+
+	img.Invert()
+	if img.At(2, 3) != 1 || img.At(3, 3) != 0 {
+		t.Errorf("got reflectance %v and %v after inverted, want 1 and 0", img.At(2, 3), img.At(3, 3))
+	}
+}
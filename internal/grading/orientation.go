@@ -0,0 +1,89 @@
+package grading
+
+// Orientation is the orientation of the symbol in the image detected by the decoders.
+// The rows and the columns of the mirrored symbol are swapped,
+// and then the symbol is rotated clockwise by Rotation degrees.
+type Orientation struct {
+	Mirrored bool
+	Inverted bool
+	Rotation int
+}
+
+// rotate returns the point (x, y) of the image of w x h modules
+// rotated clockwise by degrees, and the size of the rotated image.
+func rotate(x, y, w, h, degrees int) (int, int, int, int) {
+	switch degrees {
+	case 90:
+		return h - 1 - y, x, h, w
+	case 180:
+		return w - 1 - x, h - 1 - y, w, h
+	case 270:
+		return y, w - 1 - x, h, w
+	}
+	return x, y, w, h
+}
+
+// Oriented is the measurement of the symbol seen in its normal orientation,
+// dark on light, upright and not mirrored.
+// The modules are in the coordinates of the symbol restored by the decoders.
+type Oriented struct {
+	m *Measurement
+	o Orientation
+
+	// the size of the symbol in the normal orientation
+	cols, rows int
+}
+
+// Orient returns the measurement of the symbol in the orientation o seen in the normal orientation.
+func (m *Measurement) Orient(o Orientation) *Oriented {
+	cols, rows := m.Grid.Cols, m.Grid.Rows
+	if (o.Rotation%180 == 90) != o.Mirrored {
+		cols, rows = rows, cols
+	}
+	return &Oriented{m: m, o: o, cols: cols, rows: rows}
+}
+
+// Measured returns the module of the measurement at the module (x, y) of the symbol.
+func (v *Oriented) Measured(x, y int) (int, int) {
+	w, h := v.cols, v.rows
+	if v.o.Mirrored {
+		x, y, w, h = y, x, h, w
+	}
+	x, y, _, _ = rotate(x, y, w, h, v.o.Rotation)
+	return x, y
+}
+
+// Symbol returns the module of the symbol at the module (x, y) of the measurement.
+func (v *Oriented) Symbol(x, y int) (int, int) {
+	x, y, _, _ = rotate(x, y, v.m.Grid.Cols, v.m.Grid.Rows, (360-v.o.Rotation)%360)
+	if v.o.Mirrored {
+		x, y = y, x
+	}
+	return x, y
+}
+
+// Dark reports whether the module at (x, y) is dark in the normal orientation.
+func (v *Oriented) Dark(x, y int) bool {
+	return v.m.Dark(v.Measured(x, y)) != v.o.Inverted
+}
+
+// Modulation returns the modulation of the module at (x, y).
+func (v *Oriented) Modulation(x, y int) float64 {
+	return v.m.Modulation(v.Measured(x, y))
+}
+
+// Margin returns the reflectance margin of the module at (x, y),
+// which should be dark in the normal orientation if dark is true.
+func (v *Oriented) Margin(x, y int, dark bool) float64 {
+	mx, my := v.Measured(x, y)
+	return v.m.Margin(mx, my, dark != v.o.Inverted)
+}
+
+// GridNonuniformity returns the grid non-uniformity of the measurement.
+// dark and function are in the normal orientation, as [Oriented.Dark].
+func (v *Oriented) GridNonuniformity(dark, function func(x, y int) bool) float64 {
+	return v.m.GridNonuniformity(
+		func(x, y int) bool { return dark(v.Symbol(x, y)) != v.o.Inverted },
+		func(x, y int) bool { return function(v.Symbol(x, y)) },
+	)
+}
@@ -0,0 +1,43 @@
+package grading
+
+import "testing"
+
+func TestOriented(t *testing.T) {
+	// the measurement of 3x2 modules, and the symbol of 2x3 modules when it is rotated.
+	m := &Measurement{Grid: Grid{Cols: 3, Rows: 2}}
+	tests := []struct {
+		o    Orientation
+		cols int
+		rows int
+		// the module of the measurement at the top-left module of the symbol
+		x, y int
+	}{
+		{Orientation{}, 3, 2, 0, 0},
+		{Orientation{Rotation: 90}, 2, 3, 2, 0},
+		{Orientation{Rotation: 180}, 3, 2, 2, 1},
+		{Orientation{Rotation: 270}, 2, 3, 0, 1},
+		{Orientation{Mirrored: true}, 2, 3, 0, 0},
+		{Orientation{Mirrored: true, Rotation: 90}, 3, 2, 2, 0},
+	}
+	for _, tt := range tests {
+		v := m.Orient(tt.o)
+		if v.cols != tt.cols || v.rows != tt.rows {
+			t.Errorf("%+v: got %dx%d, want %dx%d", tt.o, v.cols, v.rows, tt.cols, tt.rows)
+		}
+		if x, y := v.Measured(0, 0); x != tt.x || y != tt.y {
+			t.Errorf("%+v: got (%d, %d), want (%d, %d)", tt.o, x, y, tt.x, tt.y)
+		}
+		for y := 0; y < v.rows; y++ {
+			for x := 0; x < v.cols; x++ {
+				mx, my := v.Measured(x, y)
+				if mx < 0 || mx >= m.Grid.Cols || my < 0 || my >= m.Grid.Rows {
+					t.Errorf("%+v: (%d, %d) is measured at (%d, %d)", tt.o, x, y, mx, my)
+					continue
+				}
+				if sx, sy := v.Symbol(mx, my); sx != x || sy != y {
+					t.Errorf("%+v: got (%d, %d), want (%d, %d)", tt.o, sx, sy, x, y)
+				}
+			}
+		}
+	}
+}
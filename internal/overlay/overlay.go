@@ -0,0 +1,180 @@
+// Package overlay renders the debug images that show the roles of the modules,
+// which is shared by QR Code, Micro QR Code and rMQR Code.
+package overlay
+
+import (
+	"image"
+	"image/color"
+	"math"
+
+	"github.com/shogo82148/qrcode/internal/grading"
+)
+
+// Kind is a kind of a module.
+type Kind int
+
+const (
+	KindQuietZone Kind = iota
+	KindFunction
+	KindFormat
+	KindData
+	KindErrorCorrection
+	KindRemainder
+)
+
+// Module is a module of a symbol.
+type Module struct {
+	Kind Kind
+
+	// Block is the index of the Reed-Solomon block of the codeword modules.
+	Block int
+
+	// Dark reports whether the module is dark.
+	Dark bool
+
+	// Corrected reports whether the module differs from the symbol restored by the decoder.
+	Corrected bool
+}
+
+var (
+	colorQuietZone = color.NRGBA{0xff, 0xff, 0xff, 0xff}
+	colorFunction  = color.NRGBA{0x30, 0x30, 0x90, 0xff}
+	colorFormat    = color.NRGBA{0x80, 0x30, 0xa0, 0xff}
+	colorRemainder = color.NRGBA{0x80, 0x80, 0x80, 0xff}
+	colorCorrected = color.NRGBA{0xd0, 0x00, 0x00, 0xff}
+
+	// the colors of the data codewords of each block.
+	paletteData = []color.NRGBA{
+		{0x2e, 0x8b, 0x57, 0xff},
+		{0x00, 0x80, 0x80, 0xff},
+		{0x6b, 0x8e, 0x23, 0xff},
+		{0x3c, 0xb3, 0x71, 0xff},
+		{0x20, 0xb2, 0xaa, 0xff},
+		{0x55, 0x6b, 0x2f, 0xff},
+	}
+
+	// the colors of the error correction codewords of each block.
+	paletteErrorCorrection = []color.NRGBA{
+		{0xd2, 0x69, 0x1e, 0xff},
+		{0xda, 0xa5, 0x20, 0xff},
+		{0xcd, 0x85, 0x3f, 0xff},
+		{0xb8, 0x86, 0x0b, 0xff},
+		{0xff, 0x8c, 0x00, 0xff},
+		{0xa0, 0x52, 0x2d, 0xff},
+	}
+)
+
+// Color returns the deep color of m, regardless of whether it is dark.
+func (m Module) Color() color.NRGBA {
+	if m.Corrected {
+		return colorCorrected
+	}
+	switch m.Kind {
+	case KindFunction:
+		return colorFunction
+	case KindFormat:
+		return colorFormat
+	case KindData:
+		return paletteData[m.Block%len(paletteData)]
+	case KindErrorCorrection:
+		return paletteErrorCorrection[m.Block%len(paletteErrorCorrection)]
+	case KindRemainder:
+		return colorRemainder
+	}
+	return colorQuietZone
+}
+
+// pale returns the pale color of m for the light modules.
+func (m Module) pale() color.NRGBA {
+	c := m.Color()
+	return mix(c, colorQuietZone, 0.7)
+}
+
+// mix mixes c1 and c2 in the ratio of (1-t):t.
+func mix(c1, c2 color.NRGBA, t float64) color.NRGBA {
+	f := func(a, b uint8) uint8 {
+		return uint8(math.Round(float64(a)*(1-t) + float64(b)*t))
+	}
+	return color.NRGBA{f(c1.R, c2.R), f(c1.G, c2.G), f(c1.B, c2.B), 0xff}
+}
+
+// Symbol is the modules of a symbol.
+type Symbol struct {
+	Cols, Rows int
+
+	// Modules is the modules in row-major order.
+	Modules []Module
+}
+
+// New returns a new symbol of cols x rows modules.
+func New(cols, rows int) *Symbol {
+	return &Symbol{
+		Cols:    cols,
+		Rows:    rows,
+		Modules: make([]Module, cols*rows),
+	}
+}
+
+// At returns the module at (x, y).
+// It returns a light module of the quiet zone if (x, y) is outside of the symbol.
+func (s *Symbol) At(x, y int) Module {
+	if x < 0 || y < 0 || x >= s.Cols || y >= s.Rows {
+		return Module{}
+	}
+	return s.Modules[y*s.Cols+x]
+}
+
+// Set sets the module at (x, y).
+func (s *Symbol) Set(x, y int, m Module) {
+	s.Modules[y*s.Cols+x] = m
+}
+
+// Render renders s with the module size of scale pixels and the quiet zone of quietZone modules.
+// The dark modules are drawn in the deep colors and the light modules in the pale colors.
+func (s *Symbol) Render(scale, quietZone int) *image.NRGBA {
+	scale = max(scale, 1)
+	w, h := s.Cols+quietZone*2, s.Rows+quietZone*2
+	img := image.NewNRGBA(image.Rect(0, 0, w*scale, h*scale))
+	for y := 0; y < h*scale; y++ {
+		for x := 0; x < w*scale; x++ {
+			m := s.At(x/scale-quietZone, y/scale-quietZone)
+			c := m.pale()
+			if m.Dark {
+				c = m.Color()
+			}
+			img.SetNRGBA(x, y, c)
+		}
+	}
+	return img
+}
+
+// Blend tints the captured image measured by m in the deep colors of the modules.
+// The symbol is in the orientation o in the image, and s is in the normal orientation.
+// The sampling points at the centers of the modules are drawn without tinting.
+func (s *Symbol) Blend(m *grading.Measurement, o grading.Orientation) *image.NRGBA {
+	sym := m.Orient(o)
+	rect := m.Image.Rect
+	px, py := m.Grid.Pitch()
+	img := image.NewNRGBA(rect)
+	for y := rect.Min.Y; y < rect.Max.Y; y++ {
+		for x := rect.Min.X; x < rect.Max.X; x++ {
+			v := uint8(math.Round(m.Image.At(x, y) * 0xff))
+			gray := color.NRGBA{v, v, v, 0xff}
+			fx := (float64(x) + 0.5 - float64(m.Grid.Rect.Min.X)) / px
+			fy := (float64(y) + 0.5 - float64(m.Grid.Rect.Min.Y)) / py
+			mx, my := int(math.Floor(fx)), int(math.Floor(fy))
+			if mx < 0 || my < 0 || mx >= m.Grid.Cols || my >= m.Grid.Rows {
+				img.SetNRGBA(x, y, gray)
+				continue
+			}
+			c := s.At(sym.Symbol(mx, my)).Color()
+			cx, cy := m.Grid.Center(mx, my)
+			if int(math.Floor(cx)) == x && int(math.Floor(cy)) == y {
+				img.SetNRGBA(x, y, c)
+				continue
+			}
+			img.SetNRGBA(x, y, mix(gray, c, 0.5))
+		}
+	}
+	return img
+}
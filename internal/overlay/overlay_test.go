@@ -0,0 +1,75 @@
+package overlay
+
+import (
+	"image"
+	"image/color"
+	"testing"
+
+	"github.com/shogo82148/qrcode/internal/grading"
+)
+
+func TestSymbol_Render(t *testing.T) {
+	s := New(3, 2)
+	s.Set(0, 0, Module{Kind: KindFunction, Dark: true})
+	s.Set(1, 0, Module{Kind: KindData, Dark: true, Corrected: true})
+	s.Set(2, 1, Module{Kind: KindErrorCorrection, Block: 1})
+
+	img := s.Render(2, 1)
+	if want := image.Rect(0, 0, 10, 8); img.Bounds() != want {
+		t.Fatalf("got %v, want %v", img.Bounds(), want)
+	}
+	tests := []struct {
+		x, y int
+		want color.NRGBA
+	}{
+		{-1, -1, colorQuietZone},
+		{0, 0, colorFunction},
+		{1, 0, colorCorrected},
+		{2, 1, mix(paletteErrorCorrection[1], colorQuietZone, 0.7)},
+	}
+	for _, tt := range tests {
+		for _, d := range []image.Point{{0, 0}, {1, 1}} {
+			if got := img.NRGBAAt((tt.x+1)*2+d.X, (tt.y+1)*2+d.Y); got != tt.want {
+				t.Errorf("module (%d, %d): got %v, want %v", tt.x, tt.y, got, tt.want)
+			}
+		}
+	}
+}
+
+func TestSymbol_Blend(t *testing.T) {
+	// the image of 3 x 2 modules of 4 x 4 pixels with the quiet zone of 1 module,
+	// which is the symbol of 2 x 3 modules rotated clockwise by 90 degrees.
+	img := &grading.Image{
+		Rect:   image.Rect(0, 0, 20, 16),
+		Pix:    make([]float64, 20*16),
+		Stride: 20,
+	}
+	for i := range img.Pix {
+		img.Pix[i] = 1
+	}
+	m := grading.Measure(img, grading.Grid{Rect: image.Rect(4, 4, 16, 12), Cols: 3, Rows: 2})
+
+	s := New(2, 3)
+	s.Set(0, 0, Module{Kind: KindFunction})
+	got := s.Blend(m, grading.Orientation{Rotation: 90})
+	if got.Bounds() != img.Rect {
+		t.Fatalf("got %v, want %v", got.Bounds(), img.Rect)
+	}
+
+	// the quiet zone is not tinted.
+	if c := got.NRGBAAt(0, 0); c != colorQuietZone {
+		t.Errorf("got %v, want %v", c, colorQuietZone)
+	}
+
+	// the top left module of the symbol is at the top right in the image,
+	// and its sampling point is drawn in the deep color.
+	if c := got.NRGBAAt(4*3+2, 4+2); c != colorFunction {
+		t.Errorf("got %v, want %v", c, colorFunction)
+	}
+	if c, want := got.NRGBAAt(4*3, 4), mix(color.NRGBA{0xff, 0xff, 0xff, 0xff}, colorFunction, 0.5); c != want {
+		t.Errorf("got %v, want %v", c, want)
+	}
+	if c := got.NRGBAAt(4+2, 4+2); c == colorFunction {
+		t.Errorf("got %v at the top left, want another color", c)
+	}
+}
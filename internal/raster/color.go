@@ -0,0 +1,84 @@
+package raster
+
+import (
+	"image/color"
+	"math"
+)
+
+// LinearColor is a premultiplied color in the linear light.
+// The colors are blended in the linear light, and converted into the sRGB colors at the end.
+type LinearColor struct {
+	R, G, B, A float64
+}
+
+// ToLinear converts c into the linear light.
+func ToLinear(c color.Color) LinearColor {
+	n := color.NRGBA64Model.Convert(c).(color.NRGBA64)
+	a := float64(n.A) / 0xffff
+	return LinearColor{
+		R: SRGBToLinear(float64(n.R)/0xffff) * a,
+		G: SRGBToLinear(float64(n.G)/0xffff) * a,
+		B: SRGBToLinear(float64(n.B)/0xffff) * a,
+		A: a,
+	}
+}
+
+// Add returns the sum of c and d.
+func (c LinearColor) Add(d LinearColor) LinearColor {
+	return LinearColor{c.R + d.R, c.G + d.G, c.B + d.B, c.A + d.A}
+}
+
+// Scale returns c multiplied by s.
+func (c LinearColor) Scale(s float64) LinearColor {
+	return LinearColor{c.R * s, c.G * s, c.B * s, c.A * s}
+}
+
+// NRGBA64 converts c into the sRGB color.
+func (c LinearColor) NRGBA64() color.NRGBA64 {
+	if c.A <= 0 {
+		return color.NRGBA64{}
+	}
+	conv := func(v float64) uint16 {
+		v = LinearToSRGB(min(1, max(0, v/c.A)))
+		return uint16(math.Round(v * 0xffff))
+	}
+	return color.NRGBA64{
+		R: conv(c.R),
+		G: conv(c.G),
+		B: conv(c.B),
+		A: uint16(math.Round(min(1, c.A) * 0xffff)),
+	}
+}
+
+// NRGBA converts c into the 8-bit sRGB color.
+func (c LinearColor) NRGBA() color.NRGBA {
+	if c.A <= 0 {
+		return color.NRGBA{}
+	}
+	conv := func(v float64) uint8 {
+		v = LinearToSRGB(min(1, max(0, v/c.A)))
+		return uint8(math.Round(v * 0xff))
+	}
+	return color.NRGBA{
+		R: conv(c.R),
+		G: conv(c.G),
+		B: conv(c.B),
+		A: uint8(math.Round(min(1, c.A) * 0xff)),
+	}
+}
+
+// SRGBToLinear converts the sRGB component v in [0, 1] into the linear light.
+func SRGBToLinear(v float64) float64 {
+	if v <= 0.04045 {
+		return v / 12.92
+	}
+	return math.Pow((v+0.055)/1.055, 2.4)
+}
+
+// LinearToSRGB converts the linear light v in [0, 1] into the sRGB component.
+func LinearToSRGB(v float64) float64 {
+	if v <= 0.0031308 {
+		return v * 12.92
+	}
+	return 1.055*math.Pow(v, 1/2.4) - 0.055
+}
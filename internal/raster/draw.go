@@ -0,0 +1,114 @@
+package raster
+
+import (
+	"errors"
+	"image"
+	"image/color"
+	"image/draw"
+	"math"
+
+	"github.com/shogo82148/go-imaging/bitmap"
+)
+
+// Fit returns the affine transform that fits the symbol of size modules with the quiet zone into r.
+// The symbol is rotated clockwise by degrees around its center,
+// and the aspect ratio is kept.
+// The matrix is in the same layout as [Draw].
+func Fit(size image.Point, quietZone int, r image.Rectangle, degrees float64) [6]float64 {
+	w := float64(size.X + quietZone*2)
+	h := float64(size.Y + quietZone*2)
+	sin, cos := math.Sincos(degrees * math.Pi / 180)
+
+	// the bounding box of the rotated symbol
+	bw := w*math.Abs(cos) + h*math.Abs(sin)
+	bh := w*math.Abs(sin) + h*math.Abs(cos)
+	s := min(float64(r.Dx())/bw, float64(r.Dy())/bh)
+
+	// the centers of the symbol and the rectangle
+	cx, cy := float64(size.X)/2, float64(size.Y)/2
+	rx, ry := float64(r.Min.X+r.Max.X)/2, float64(r.Min.Y+r.Max.Y)/2
+
+	a, b := s*cos, -s*sin
+	d, e := s*sin, s*cos
+	return [6]float64{
+		a, b, rx - (a*cx + b*cy),
+		d, e, ry - (d*cx + e*cy),
+	}
+}
+
+// Draw draws img with the quiet zone onto dst.
+//
+// m is the affine transform from module coordinates to pixel coordinates of dst,
+// in the same layout as f64.Aff3 of golang.org/x/image/math/f64:
+// a point (x, y) is mapped to (m[0]*x + m[1]*y + m[2], m[3]*x + m[4]*y + m[5]).
+// The top-left corner of the symbol without the quiet zone is the origin of module coordinates.
+//
+// The dark modules are painted with fg, and the light modules and the quiet zone are painted with bg.
+// The edges are antialiased by super-sampling, and the symbol is composited over dst
+// with the Porter-Duff "over" operator in the linear light, so the translucent colors show dst through them.
+func Draw(dst draw.Image, img *bitmap.Image, quietZone int, m [6]float64, fg, bg color.Color) error {
+	det := m[0]*m[4] - m[1]*m[3]
+	if det == 0 || math.IsNaN(det) || math.IsInf(det, 0) {
+		return errors.New("raster: singular transform")
+	}
+	// the inverse transform
+	inv := [6]float64{
+		m[4] / det, -m[1] / det, (m[1]*m[5] - m[4]*m[2]) / det,
+		-m[3] / det, m[0] / det, (m[3]*m[2] - m[0]*m[5]) / det,
+	}
+
+	bounds := img.Bounds()
+	x0, y0 := float64(-quietZone), float64(-quietZone)
+	x1, y1 := float64(bounds.Dx()+quietZone), float64(bounds.Dy()+quietZone)
+
+	// the bounding box of the symbol in dst
+	minX, minY := math.Inf(1), math.Inf(1)
+	maxX, maxY := math.Inf(-1), math.Inf(-1)
+	for _, p := range [...][2]float64{{x0, y0}, {x1, y0}, {x1, y1}, {x0, y1}} {
+		X := m[0]*p[0] + m[1]*p[1] + m[2]
+		Y := m[3]*p[0] + m[4]*p[1] + m[5]
+		minX, minY = min(minX, X), min(minY, Y)
+		maxX, maxY = max(maxX, X), max(maxY, Y)
+	}
+	r := image.Rect(
+		int(math.Floor(minX)), int(math.Floor(minY)),
+		int(math.Ceil(maxX)), int(math.Ceil(maxY)),
+	).Intersect(dst.Bounds())
+
+	fgl, bgl := ToLinear(fg), ToLinear(bg)
+
+	// each pixel is super-sampled with ss x ss points.
+	const ss = 4
+	for y := r.Min.Y; y < r.Max.Y; y++ {
+		for x := r.Min.X; x < r.Max.X; x++ {
+			var dark, light int
+			for j := 0; j < ss; j++ {
+				Y := float64(y) + (float64(j)+0.5)/ss
+				for i := 0; i < ss; i++ {
+					X := float64(x) + (float64(i)+0.5)/ss
+					u := inv[0]*X + inv[1]*Y + inv[2]
+					v := inv[3]*X + inv[4]*Y + inv[5]
+					if u < x0 || u >= x1 || v < y0 || v >= y1 {
+						continue
+					}
+					mx, my := int(math.Floor(u)), int(math.Floor(v))
+					if img.BinaryAt(mx+bounds.Min.X, my+bounds.Min.Y) {
+						dark++
+					} else {
+						light++
+					}
+				}
+			}
+			if dark+light == 0 {
+				continue
+			}
+
+			// the premultiplied source color weighted by the coverage,
+			// which is composited over the destination pixel by the Porter-Duff "over" operator.
+			src := fgl.Scale(float64(dark) / (ss * ss)).Add(bgl.Scale(float64(light) / (ss * ss)))
+			c := ToLinear(dst.At(x, y)).Scale(1 - src.A).Add(src)
+			dst.Set(x, y, c.NRGBA64())
+		}
+	}
+	return nil
+}
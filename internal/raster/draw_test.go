@@ -0,0 +1,113 @@
+package raster
+
+import (
+	"image"
+	"image/color"
+	"math"
+	"testing"
+
+	"github.com/shogo82148/go-imaging/bitmap"
+)
+
+func TestFit(t *testing.T) {
+	// 3x1 modules with the quiet zone of 1 module is 5x3 modules.
+	size := image.Pt(3, 1)
+	tests := []struct {
+		degrees float64
+		r       image.Rectangle
+		in      [2]float64
+		want    [2]float64
+	}{
+		{0, image.Rect(0, 0, 50, 30), [2]float64{-1, -1}, [2]float64{0, 0}},
+		{0, image.Rect(0, 0, 50, 30), [2]float64{4, 2}, [2]float64{50, 30}},
+		{0, image.Rect(0, 0, 100, 30), [2]float64{-1, -1}, [2]float64{25, 0}},
+		{90, image.Rect(0, 0, 30, 50), [2]float64{-1, -1}, [2]float64{30, 0}},
+		{90, image.Rect(0, 0, 30, 50), [2]float64{4, 2}, [2]float64{0, 50}},
+	}
+	for i, tt := range tests {
+		m := Fit(size, 1, tt.r, tt.degrees)
+		x := m[0]*tt.in[0] + m[1]*tt.in[1] + m[2]
+		y := m[3]*tt.in[0] + m[4]*tt.in[1] + m[5]
+		if math.Abs(x-tt.want[0]) > 1e-9 || math.Abs(y-tt.want[1]) > 1e-9 {
+			t.Errorf("%d: got (%f, %f), want (%f, %f)", i, x, y, tt.want[0], tt.want[1])
+		}
+	}
+}
+
+func TestDraw(t *testing.T) {
+	img := bitmap.New(image.Rect(0, 0, 2, 1))
+	img.SetBinary(0, 0, bitmap.Black)
+
+	dst := image.NewNRGBA(image.Rect(0, 0, 60, 40))
+	for i := range dst.Pix {
+		dst.Pix[i] = 0x80
+	}
+	m := [6]float64{10, 0, 15, 0, 10, 15}
+	if err := Draw(dst, img, 1, m, color.Black, color.White); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		x, y int
+		want color.NRGBA
+	}{
+		{20, 20, color.NRGBA{0, 0, 0, 0xff}},          // the dark module
+		{30, 20, color.NRGBA{0xff, 0xff, 0xff, 0xff}}, // the light module
+		{10, 10, color.NRGBA{0xff, 0xff, 0xff, 0xff}}, // the quiet zone
+		{2, 2, color.NRGBA{0x80, 0x80, 0x80, 0x80}},   // outside
+	}
+	for _, tt := range tests {
+		if got := dst.NRGBAAt(tt.x, tt.y); got != tt.want {
+			t.Errorf("(%d, %d): got %v, want %v", tt.x, tt.y, got, tt.want)
+		}
+	}
+
+	// the edges are antialiased.
+	m = [6]float64{10, 0, 10, 0, 10, 10}
+	m[2] += 0.5
+	if err := Draw(dst, img, 0, m, color.Black, color.White); err != nil {
+		t.Fatal(err)
+	}
+	if got := dst.NRGBAAt(20, 15); got.R == 0 || got.R == 0xff {
+		t.Errorf("the edge is not antialiased: %v", got)
+	}
+
+	if err := Draw(dst, img, 0, [6]float64{}, color.Black, color.White); err == nil {
+		t.Error("want error, got nil")
+	}
+}
+
+func TestDraw_Translucent(t *testing.T) {
+	img := bitmap.New(image.Rect(0, 0, 1, 1))
+	img.SetBinary(0, 0, bitmap.Black)
+
+	dst := image.NewNRGBA(image.Rect(0, 0, 30, 10))
+	for i := 0; i < len(dst.Pix); i += 4 {
+		copy(dst.Pix[i:], []uint8{0xff, 0, 0, 0xff})
+	}
+
+	// the half transparent black over the red background,
+	// and the transparent quiet zone keeps the background.
+	fg := color.NRGBA{0, 0, 0, 0x80}
+	bg := color.NRGBA{}
+	m := [6]float64{10, 0, 10, 0, 10, 0}
+	if err := Draw(dst, img, 1, m, fg, bg); err != nil {
+		t.Fatal(err)
+	}
+
+	alpha := float64(0x80) / 0xff
+	want := uint8(math.Round(LinearToSRGB(1-alpha) * 0xff))
+	if got := dst.NRGBAAt(15, 5); got.A != 0xff || got.G != 0 || got.B != 0 || absDiff(got.R, want) > 1 {
+		t.Errorf("the module: got %v, want R = %d", got, want)
+	}
+	if got := dst.NRGBAAt(5, 5); got != (color.NRGBA{0xff, 0, 0, 0xff}) {
+		t.Errorf("the quiet zone: got %v, want red", got)
+	}
+}
+
+func absDiff(a, b uint8) uint8 {
+	if a > b {
+		return a - b
+	}
+	return b - a
+}
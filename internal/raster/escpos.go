@@ -0,0 +1,44 @@
+package raster
+
+import (
+	"bufio"
+	"io"
+
+	"github.com/shogo82148/go-imaging/bitmap"
+)
+
+// maxRasterHeight is the maximum number of rows in a GS v 0 command.
+const maxRasterHeight = 2303
+
+// EncodeESCPOS writes img as ESC/POS raster bit image commands (GS v 0) to w.
+// The pixels are the printer dots.
+// The tall images are split into multiple commands.
+func EncodeESCPOS(w io.Writer, img *bitmap.Image, l Layout) error {
+	dx, dy := l.Rect.Dx(), l.Rect.Dy()
+	bytesPerRow := (dx + 7) / 8
+
+	bw := bufio.NewWriter(w)
+	row := make([]uint8, dx)
+	packed := make([]uint8, bytesPerRow)
+	for y := 0; y < dy; y++ {
+		if y%maxRasterHeight == 0 {
+			h := min(maxRasterHeight, dy-y)
+			bw.Write([]byte{
+				0x1d, 'v', '0', 0, // GS v 0 m
+				byte(bytesPerRow), byte(bytesPerRow >> 8), // xL xH
+				byte(h), byte(h >> 8), // yL yH
+			})
+		}
+		l.Row(img, y+l.Rect.Min.Y, row)
+		for i := range packed {
+			packed[i] = 0
+		}
+		for x, v := range row {
+			if v != 0 {
+				packed[x/8] |= 0x80 >> (x % 8)
+			}
+		}
+		bw.Write(packed)
+	}
+	return bw.Flush()
+}
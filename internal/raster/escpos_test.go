@@ -0,0 +1,31 @@
+package raster
+
+import (
+	"bytes"
+	"image"
+	"testing"
+
+	"github.com/shogo82148/go-imaging/bitmap"
+)
+
+func TestEncodeESCPOS(t *testing.T) {
+	img := bitmap.New(image.Rect(0, 0, 5, 2))
+	img.SetBinary(0, 0, bitmap.Black)
+	img.SetBinary(4, 1, bitmap.Black)
+	l := NewLayout(img.Bounds().Size(), 0, 2, 0)
+
+	var buf bytes.Buffer
+	if err := EncodeESCPOS(&buf, img, l); err != nil {
+		t.Fatal(err)
+	}
+	want := []byte{
+		0x1d, 'v', '0', 0, 2, 0, 4, 0,
+		0xc0, 0x00,
+		0xc0, 0x00,
+		0x00, 0xc0,
+		0x00, 0xc0,
+	}
+	if got := buf.Bytes(); !bytes.Equal(got, want) {
+		t.Errorf("got %x, want %x", got, want)
+	}
+}
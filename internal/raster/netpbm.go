@@ -0,0 +1,387 @@
+package raster
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"image"
+	"io"
+	"strconv"
+
+	"github.com/shogo82148/go-imaging/bitmap"
+)
+
+// EncodePBM writes img as a PBM image to w.
+// If plain is true, the plain (ASCII) format P1 is used, otherwise the raw format P4 is used.
+func EncodePBM(w io.Writer, img *bitmap.Image, l Layout, plain bool) error {
+	dx, dy := l.Rect.Dx(), l.Rect.Dy()
+	bw := bufio.NewWriter(w)
+	magic := "P4"
+	if plain {
+		magic = "P1"
+	}
+	fmt.Fprintf(bw, "%s\n%d %d\n", magic, dx, dy)
+
+	row := make([]uint8, dx)
+	packed := make([]uint8, (dx+7)/8)
+	for y := 0; y < dy; y++ {
+		l.Row(img, y+l.Rect.Min.Y, row)
+		if plain {
+			writePlainRow(bw, row, "1", "0")
+			continue
+		}
+		for i := range packed {
+			packed[i] = 0
+		}
+		for x, v := range row {
+			if v != 0 {
+				packed[x/8] |= 0x80 >> (x % 8)
+			}
+		}
+		bw.Write(packed)
+	}
+	return bw.Flush()
+}
+
+// EncodePGM writes img as a PGM image to w.
+// The max value is 255, and black is 0 and white is 255.
+// If plain is true, the plain (ASCII) format P2 is used, otherwise the raw format P5 is used.
+func EncodePGM(w io.Writer, img *bitmap.Image, l Layout, plain bool) error {
+	dx, dy := l.Rect.Dx(), l.Rect.Dy()
+	bw := bufio.NewWriter(w)
+	magic := "P5"
+	if plain {
+		magic = "P2"
+	}
+	fmt.Fprintf(bw, "%s\n%d %d\n255\n", magic, dx, dy)
+
+	row := make([]uint8, dx)
+	for y := 0; y < dy; y++ {
+		l.Row(img, y+l.Rect.Min.Y, row)
+		if plain {
+			writePlainRow(bw, row, "0", "255")
+			continue
+		}
+		for x, v := range row {
+			if v != 0 {
+				row[x] = 0x00
+			} else {
+				row[x] = 0xff
+			}
+		}
+		bw.Write(row)
+	}
+	return bw.Flush()
+}
+
+// writePlainRow writes a row of plain Netpbm images.
+// Lines are wrapped so that they don't exceed 70 characters.
+func writePlainRow(w *bufio.Writer, row []uint8, black, white string) {
+	n := 0
+	for x, v := range row {
+		s := white
+		if v != 0 {
+			s = black
+		}
+		if x > 0 {
+			if n+1+len(s) > 70 {
+				w.WriteByte('\n')
+				n = 0
+			} else {
+				w.WriteByte(' ')
+				n++
+			}
+		}
+		w.WriteString(s)
+		n += len(s)
+	}
+	w.WriteByte('\n')
+}
+
+// EncodeXBM writes img as an X BitMap image to w.
+// name is the prefix of the C identifiers.
+func EncodeXBM(w io.Writer, img *bitmap.Image, l Layout, name string) error {
+	dx, dy := l.Rect.Dx(), l.Rect.Dy()
+	bw := bufio.NewWriter(w)
+	fmt.Fprintf(bw, "#define %s_width %d\n", name, dx)
+	fmt.Fprintf(bw, "#define %s_height %d\n", name, dy)
+	fmt.Fprintf(bw, "static unsigned char %s_bits[] = {\n", name)
+
+	row := make([]uint8, dx)
+	packed := make([]uint8, (dx+7)/8)
+	n := 0
+	total := len(packed) * dy
+	for y := 0; y < dy; y++ {
+		l.Row(img, y+l.Rect.Min.Y, row)
+		for i := range packed {
+			packed[i] = 0
+		}
+		// the least significant bit is the leftmost pixel.
+		for x, v := range row {
+			if v != 0 {
+				packed[x/8] |= 1 << (x % 8)
+			}
+		}
+		for _, b := range packed {
+			if n%12 == 0 {
+				bw.WriteString("   ")
+			}
+			fmt.Fprintf(bw, "0x%02x", b)
+			n++
+			switch {
+			case n == total:
+				bw.WriteString("};\n")
+			case n%12 == 0:
+				bw.WriteString(",\n")
+			default:
+				bw.WriteString(", ")
+			}
+		}
+	}
+	return bw.Flush()
+}
+
+// maxNetpbmSize and maxNetpbmPixels limit the size of the images that DecodeNetpbm accepts,
+// so that a broken header doesn't allocate the memory before any pixel is read.
+// The largest symbol fits in them with the module size of 80 pixels.
+const (
+	maxNetpbmSize   = 1 << 15
+	maxNetpbmPixels = 1 << 28
+)
+
+// DecodeNetpbm reads a PBM or PGM image from r.
+// The pixels of PGM images that are darker than the half of the max value are black.
+// The images larger than 32768 pixels on a side or 2^28 pixels in total are rejected.
+func DecodeNetpbm(r io.Reader) (*bitmap.Image, error) {
+	br := bufio.NewReader(r)
+	magic := make([]byte, 2)
+	if _, err := io.ReadFull(br, magic); err != nil {
+		return nil, err
+	}
+	if magic[0] != 'P' {
+		return nil, errors.New("raster: not a Netpbm image")
+	}
+	switch magic[1] {
+	case '1', '2', '4', '5':
+	default:
+		return nil, fmt.Errorf("raster: unsupported Netpbm format: P%c", magic[1])
+	}
+
+	width, err := readHeaderInt(br)
+	if err != nil {
+		return nil, err
+	}
+	height, err := readHeaderInt(br)
+	if err != nil {
+		return nil, err
+	}
+	maxValue := 1
+	if magic[1] == '2' || magic[1] == '5' {
+		maxValue, err = readHeaderInt(br)
+		if err != nil {
+			return nil, err
+		}
+		if maxValue <= 0 || maxValue > 0xffff {
+			return nil, fmt.Errorf("raster: invalid max value: %d", maxValue)
+		}
+	}
+	if width <= 0 || height <= 0 {
+		return nil, errors.New("raster: empty image")
+	}
+	if width > maxNetpbmSize || height > maxNetpbmSize || width*height > maxNetpbmPixels {
+		return nil, fmt.Errorf("raster: image too large: %dx%d", width, height)
+	}
+	if magic[1] == '4' || magic[1] == '5' {
+		// exactly one whitespace separates the header and the raster.
+		if _, err := br.ReadByte(); err != nil {
+			return nil, err
+		}
+	}
+
+	img := bitmap.New(image.Rect(0, 0, width, height))
+	switch magic[1] {
+	case '1':
+		for y := 0; y < height; y++ {
+			for x := 0; x < width; x++ {
+				b, err := readPlainBit(br)
+				if err != nil {
+					return nil, err
+				}
+				img.SetBinary(x, y, bitmap.Color(b == '1'))
+			}
+		}
+	case '2':
+		for y := 0; y < height; y++ {
+			for x := 0; x < width; x++ {
+				v, err := readHeaderInt(br)
+				if err != nil {
+					return nil, err
+				}
+				img.SetBinary(x, y, bitmap.Color(v*2 < maxValue))
+			}
+		}
+	case '4':
+		row := make([]byte, (width+7)/8)
+		for y := 0; y < height; y++ {
+			if _, err := io.ReadFull(br, row); err != nil {
+				return nil, err
+			}
+			for x := 0; x < width; x++ {
+				img.SetBinary(x, y, bitmap.Color(row[x/8]&(0x80>>(x%8)) != 0))
+			}
+		}
+	case '5':
+		bytesPerPixel := 1
+		if maxValue > 0xff {
+			bytesPerPixel = 2
+		}
+		row := make([]byte, width*bytesPerPixel)
+		for y := 0; y < height; y++ {
+			if _, err := io.ReadFull(br, row); err != nil {
+				return nil, err
+			}
+			for x := 0; x < width; x++ {
+				v := int(row[x])
+				if bytesPerPixel == 2 {
+					v = int(row[2*x])<<8 | int(row[2*x+1])
+				}
+				img.SetBinary(x, y, bitmap.Color(v*2 < maxValue))
+			}
+		}
+	}
+	return img, nil
+}
+
+// skipSpaces skips whitespaces and comments.
+func skipSpaces(br *bufio.Reader) error {
+	for {
+		b, err := br.ReadByte()
+		if err != nil {
+			return err
+		}
+		switch b {
+		case ' ', '\t', '\n', '\v', '\f', '\r':
+		case '#':
+			if _, err := br.ReadString('\n'); err != nil {
+				return err
+			}
+		default:
+			return br.UnreadByte()
+		}
+	}
+}
+
+func readHeaderInt(br *bufio.Reader) (int, error) {
+	if err := skipSpaces(br); err != nil {
+		return 0, err
+	}
+	var buf []byte
+	for {
+		b, err := br.ReadByte()
+		if err == io.EOF && len(buf) > 0 {
+			break
+		}
+		if err != nil {
+			return 0, err
+		}
+		if b < '0' || b > '9' {
+			if err := br.UnreadByte(); err != nil {
+				return 0, err
+			}
+			break
+		}
+		buf = append(buf, b)
+	}
+	if len(buf) == 0 {
+		return 0, errors.New("raster: invalid Netpbm header")
+	}
+	return strconv.Atoi(string(buf))
+}
+
+// readPlainBit reads a pixel of plain PBM images.
+// The pixels are not necessarily separated by whitespaces.
+func readPlainBit(br *bufio.Reader) (byte, error) {
+	if err := skipSpaces(br); err != nil {
+		return 0, err
+	}
+	b, err := br.ReadByte()
+	if err != nil {
+		return 0, err
+	}
+	if b != '0' && b != '1' {
+		return 0, fmt.Errorf("raster: invalid PBM pixel: %q", b)
+	}
+	return b, nil
+}
+
+// Trim removes the quiet zone around the symbol in img,
+// and reduces the image to one pixel per module.
+// The module size is estimated from the width of the finder pattern at the top-left corner,
+// which is 7 modules wide in all symbologies.
+// If all the pixels on the edges are black, the symbol is inverted and the quiet zone is black.
+// The inverted symbol is kept inverted, so that the decoders report it.
+func Trim(img *bitmap.Image) (*bitmap.Image, error) {
+	bounds := img.Bounds()
+	fg := bitmap.Black
+	if darkEdges(img) {
+		fg = bitmap.White
+	}
+
+	r := image.Rectangle{}
+	found := false
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			if img.BinaryAt(x, y) != fg {
+				continue
+			}
+			pt := image.Rect(x, y, x+1, y+1)
+			if !found {
+				r = pt
+				found = true
+			} else {
+				r = r.Union(pt)
+			}
+		}
+	}
+	if !found {
+		return nil, errors.New("raster: symbol not found")
+	}
+
+	// the length of the top border of the finder pattern.
+	run := 0
+	for x := r.Min.X; x < r.Max.X && img.BinaryAt(x, r.Min.Y) == fg; x++ {
+		run++
+	}
+	if run%7 != 0 {
+		return nil, errors.New("raster: module size is not an integer")
+	}
+	s := run / 7
+	if r.Dx()%s != 0 || r.Dy()%s != 0 {
+		return nil, errors.New("raster: module size is not an integer")
+	}
+
+	w, h := r.Dx()/s, r.Dy()/s
+	dst := bitmap.New(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			dst.SetBinary(x, y, img.BinaryAt(r.Min.X+x*s+s/2, r.Min.Y+y*s+s/2))
+		}
+	}
+	return dst, nil
+}
+
+// darkEdges reports whether all the pixels on the edges of img are black.
+func darkEdges(img *bitmap.Image) bool {
+	b := img.Bounds()
+	for x := b.Min.X; x < b.Max.X; x++ {
+		if !img.BinaryAt(x, b.Min.Y) || !img.BinaryAt(x, b.Max.Y-1) {
+			return false
+		}
+	}
+	for y := b.Min.Y; y < b.Max.Y; y++ {
+		if !img.BinaryAt(b.Min.X, y) || !img.BinaryAt(b.Max.X-1, y) {
+			return false
+		}
+	}
+	return true
+}
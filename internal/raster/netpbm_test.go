@@ -0,0 +1,116 @@
+package raster
+
+import (
+	"bytes"
+	"image"
+	"testing"
+
+	"github.com/shogo82148/go-imaging/bitmap"
+)
+
+func TestNetpbm(t *testing.T) {
+	// a finder pattern like symbol.
+	img := bitmap.New(image.Rect(0, 0, 9, 7))
+	for y := 0; y < 7; y++ {
+		for x := 0; x < 7; x++ {
+			d := max(abs(x-3), abs(y-3))
+			img.SetBinary(x, y, bitmap.Color(d != 2))
+		}
+	}
+	img.SetBinary(8, 0, bitmap.Black)
+	img.SetBinary(8, 6, bitmap.Black)
+	l := NewLayout(img.Bounds().Size(), 2, 3, 0)
+
+	encoders := map[string]func(*bytes.Buffer) error{
+		"P1": func(buf *bytes.Buffer) error { return EncodePBM(buf, img, l, true) },
+		"P4": func(buf *bytes.Buffer) error { return EncodePBM(buf, img, l, false) },
+		"P2": func(buf *bytes.Buffer) error { return EncodePGM(buf, img, l, true) },
+		"P5": func(buf *bytes.Buffer) error { return EncodePGM(buf, img, l, false) },
+	}
+	for magic, encode := range encoders {
+		var buf bytes.Buffer
+		if err := encode(&buf); err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.HasPrefix(buf.Bytes(), []byte(magic)) {
+			t.Errorf("%s: unexpected magic: %q", magic, buf.Bytes()[:2])
+		}
+
+		raw, err := DecodeNetpbm(&buf)
+		if err != nil {
+			t.Fatalf("%s: %v", magic, err)
+		}
+		if raw.Bounds() != l.Rect {
+			t.Errorf("%s: unexpected bounds: got %v, want %v", magic, raw.Bounds(), l.Rect)
+		}
+
+		got, err := Trim(raw)
+		if err != nil {
+			t.Fatalf("%s: %v", magic, err)
+		}
+		if got.Bounds() != img.Bounds() {
+			t.Fatalf("%s: unexpected bounds: got %v, want %v", magic, got.Bounds(), img.Bounds())
+		}
+		for y := 0; y < 7; y++ {
+			for x := 0; x < 9; x++ {
+				if got.BinaryAt(x, y) != img.BinaryAt(x, y) {
+					t.Errorf("%s: module (%d, %d) mismatch", magic, x, y)
+				}
+			}
+		}
+	}
+}
+
+func TestDecodeNetpbm_Comment(t *testing.T) {
+	input := "P1\n# comment\n3 2\n101\n0 1 0\n"
+	img, err := DecodeNetpbm(bytes.NewBufferString(input))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []bitmap.Color{true, false, true, false, true, false}
+	for i, c := range want {
+		if got := img.BinaryAt(i%3, i/3); got != c {
+			t.Errorf("pixel (%d, %d): got %v, want %v", i%3, i/3, got, c)
+		}
+	}
+}
+
+func TestEncodeXBM(t *testing.T) {
+	img := bitmap.New(image.Rect(0, 0, 10, 2))
+	img.SetBinary(0, 0, bitmap.Black)
+	img.SetBinary(9, 1, bitmap.Black)
+	l := NewLayout(img.Bounds().Size(), 0, 1, 0)
+
+	var buf bytes.Buffer
+	if err := EncodeXBM(&buf, img, l, "test"); err != nil {
+		t.Fatal(err)
+	}
+	want := "#define test_width 10\n" +
+		"#define test_height 2\n" +
+		"static unsigned char test_bits[] = {\n" +
+		"   0x01, 0x00, 0x00, 0x02};\n"
+	if got := buf.String(); got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
+
+func TestDecodeNetpbm_TooLarge(t *testing.T) {
+	tests := []string{
+		"P4 4294967296 4294967296\n",
+		"P4 100000 100000\n",
+		"P1 1 40000\n",
+		"P5 30000 30000 255\n",
+	}
+	for _, input := range tests {
+		if _, err := DecodeNetpbm(bytes.NewBufferString(input)); err == nil {
+			t.Errorf("%q: want error, got nil", input)
+		}
+	}
+}
@@ -0,0 +1,139 @@
+package raster
+
+import (
+	"bufio"
+	"compress/zlib"
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+	"io"
+	"math"
+
+	"github.com/shogo82148/go-imaging/bitmap"
+)
+
+// pngHeader is the signature of PNG files.
+const pngHeader = "\x89PNG\r\n\x1a\n"
+
+// maxIDATSize is the maximum size of IDAT chunks.
+const maxIDATSize = 1 << 15
+
+// EncodePNG writes img as a 1-bit grayscale PNG image to w.
+// The scanlines are compressed and written one by one,
+// so the memory usage is bounded by one row of the image.
+// If dpi is positive, the resolution is recorded in the pHYs chunk.
+func EncodePNG(w io.Writer, img *bitmap.Image, l Layout, dpi float64) error {
+	dx, dy := l.Rect.Dx(), l.Rect.Dy()
+	if dx <= 0 || dy <= 0 {
+		return errors.New("raster: empty image")
+	}
+	if uint64(dx) > math.MaxInt32 || uint64(dy) > math.MaxInt32 {
+		return errors.New("raster: image is too large")
+	}
+
+	e := &pngEncoder{w: w}
+	e.write([]byte(pngHeader))
+
+	// IHDR
+	var ihdr [13]byte
+	binary.BigEndian.PutUint32(ihdr[0:4], uint32(dx))
+	binary.BigEndian.PutUint32(ihdr[4:8], uint32(dy))
+	ihdr[8] = 1  // bit depth
+	ihdr[9] = 0  // color type: grayscale
+	ihdr[10] = 0 // compression method
+	ihdr[11] = 0 // filter method
+	ihdr[12] = 0 // interlace method
+	e.writeChunk("IHDR", ihdr[:])
+
+	// pHYs
+	if dpi > 0 {
+		// convert dots per inch to dots per meter.
+		ppm := uint32(math.Round(dpi / 0.0254))
+		var phys [9]byte
+		binary.BigEndian.PutUint32(phys[0:4], ppm)
+		binary.BigEndian.PutUint32(phys[4:8], ppm)
+		phys[8] = 1 // unit: meter
+		e.writeChunk("pHYs", phys[:])
+	}
+
+	// IDAT
+	bw := bufio.NewWriterSize(&idatWriter{e: e}, maxIDATSize)
+	zw, err := zlib.NewWriterLevel(bw, zlib.BestCompression)
+	if err != nil {
+		return err
+	}
+	row := make([]uint8, dx)
+	packed := make([]uint8, 1+(dx+7)/8) // the first byte is the filter type.
+	for y := 0; y < dy; y++ {
+		if y == 0 || !l.sameRow(y-1+l.Rect.Min.Y, y+l.Rect.Min.Y) {
+			l.Row(img, y+l.Rect.Min.Y, row)
+			pack(packed[1:], row)
+		}
+		if _, err := zw.Write(packed); err != nil {
+			return err
+		}
+	}
+	if err := zw.Close(); err != nil {
+		return err
+	}
+	if err := bw.Flush(); err != nil {
+		return err
+	}
+
+	// IEND
+	e.writeChunk("IEND", nil)
+	return e.err
+}
+
+// pack packs row into 1-bit pixels.
+// In grayscale PNG images, 0 is black and 1 is white.
+func pack(dst []uint8, row []uint8) {
+	for i := range dst {
+		dst[i] = 0
+	}
+	for x, v := range row {
+		if v == 0 {
+			dst[x/8] |= 0x80 >> (x % 8)
+		}
+	}
+}
+
+type pngEncoder struct {
+	w   io.Writer
+	err error
+}
+
+func (e *pngEncoder) write(b []byte) {
+	if e.err != nil {
+		return
+	}
+	_, e.err = e.w.Write(b)
+}
+
+func (e *pngEncoder) writeChunk(name string, data []byte) {
+	var header [8]byte
+	binary.BigEndian.PutUint32(header[:4], uint32(len(data)))
+	copy(header[4:], name)
+	crc := crc32.NewIEEE()
+	crc.Write(header[4:])
+	crc.Write(data)
+	var footer [4]byte
+	binary.BigEndian.PutUint32(footer[:], crc.Sum32())
+
+	e.write(header[:])
+	e.write(data)
+	e.write(footer[:])
+}
+
+// idatWriter writes the compressed data as IDAT chunks.
+type idatWriter struct {
+	e *pngEncoder
+}
+
+func (w *idatWriter) Write(b []byte) (int, error) {
+	w.e.writeChunk("IDAT", b)
+	if w.e.err != nil {
+		return 0, w.e.err
+	}
+	return len(b), nil
+}
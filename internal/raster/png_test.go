@@ -0,0 +1,57 @@
+package raster
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	"image/png"
+	"testing"
+
+	"github.com/shogo82148/go-imaging/bitmap"
+)
+
+func TestEncodePNG(t *testing.T) {
+	img := bitmap.New(image.Rect(0, 0, 5, 3))
+	img.SetBinary(0, 0, bitmap.Black)
+	img.SetBinary(2, 1, bitmap.Black)
+	img.SetBinary(4, 2, bitmap.Black)
+	l := NewLayout(img.Bounds().Size(), 2, 3, 0)
+
+	var buf bytes.Buffer
+	if err := EncodePNG(&buf, img, l, 300); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := png.Decode(bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := Paletted(img, l)
+	if got.Bounds() != want.Bounds() {
+		t.Fatalf("unexpected bounds: got %v, want %v", got.Bounds(), want.Bounds())
+	}
+	for y := want.Rect.Min.Y; y < want.Rect.Max.Y; y++ {
+		for x := want.Rect.Min.X; x < want.Rect.Max.X; x++ {
+			r, _, _, _ := got.At(x, y).RGBA()
+			if (r == 0) != (want.ColorIndexAt(x, y) == 1) {
+				t.Fatalf("pixel (%d, %d) mismatch", x, y)
+			}
+		}
+	}
+
+	// 300 dpi is 11811 dots per meter.
+	idx := bytes.Index(buf.Bytes(), []byte("pHYs"))
+	if idx < 0 {
+		t.Fatal("pHYs chunk not found")
+	}
+	phys := buf.Bytes()[idx+4 : idx+13]
+	if x := binary.BigEndian.Uint32(phys[0:4]); x != 11811 {
+		t.Errorf("unexpected x resolution: got %d, want %d", x, 11811)
+	}
+	if y := binary.BigEndian.Uint32(phys[4:8]); y != 11811 {
+		t.Errorf("unexpected y resolution: got %d, want %d", y, 11811)
+	}
+	if phys[8] != 1 {
+		t.Errorf("unexpected unit: got %d, want %d", phys[8], 1)
+	}
+}
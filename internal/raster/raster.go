@@ -0,0 +1,223 @@
+// Package raster renders symbols on the pixel grid with whole pixels per module.
+package raster
+
+import (
+	"image"
+	"image/color"
+	"math"
+
+	"github.com/shogo82148/go-imaging/bitmap"
+)
+
+// Palette is the palette of 1-bit images.
+// The index 0 is white and the index 1 is black.
+var Palette = color.Palette{color.White, color.Black}
+
+// Layout places the modules of a symbol on the pixel grid.
+type Layout struct {
+	// Rect is the bounds of the image.
+	Rect image.Rectangle
+
+	// Origin is the position of the top-left module of the symbol in pixels.
+	Origin image.Point
+
+	// ModuleSize is the number of pixels per module.
+	ModuleSize int
+
+	// Reduction is the number of pixels removed from the width of dark modules
+	// at the edges next to light modules, to compensate the dot gain in printing.
+	// Half of it is removed from each side.
+	Reduction int
+
+	// Inverted reports whether the colors are inverted.
+	// The symbol is rendered light on dark, and the quiet zone is dark.
+	Inverted bool
+}
+
+// NewLayout returns a layout for the symbol of size modules.
+// The width of the image is the larger of the width calculated from moduleSize and width.
+// The module size is snapped to whole pixels, and the quiet zone absorbs the remaining pixels.
+func NewLayout(size image.Point, quietZone int, moduleSize float64, width int) Layout {
+	w := size.X + quietZone*2
+	h := size.Y + quietZone*2
+	W := max(
+		int(math.Ceil(float64(w)*moduleSize)),
+		width,
+	)
+	s := max(1, W/w)
+	W = max(W, w*s)
+	extra := W - w*s
+	return Layout{
+		Rect:       image.Rect(0, 0, W, h*s+extra),
+		Origin:     image.Pt(quietZone*s+extra/2, quietZone*s+extra/2),
+		ModuleSize: s,
+	}
+}
+
+// Module returns the position of the module that contains the pixel (x, y).
+func (l Layout) Module(x, y int) (int, int) {
+	return floorDiv(x-l.Origin.X, l.ModuleSize), floorDiv(y-l.Origin.Y, l.ModuleSize)
+}
+
+// BinaryAt returns the color of the pixel (x, y).
+func (l Layout) BinaryAt(img *bitmap.Image, x, y int) bitmap.Color {
+	mx, my := l.Module(x, y)
+	mx, my = mx+img.Rect.Min.X, my+img.Rect.Min.Y
+	c := l.at(img, mx, my)
+	if !c || l.Reduction <= 0 {
+		return c
+	}
+
+	// dot-gain compensation
+	switch l.band(x - l.Origin.X) {
+	case bandLow:
+		if !l.at(img, mx-1, my) {
+			return bitmap.White
+		}
+	case bandHigh:
+		if !l.at(img, mx+1, my) {
+			return bitmap.White
+		}
+	}
+	switch l.band(y - l.Origin.Y) {
+	case bandLow:
+		if !l.at(img, mx, my-1) {
+			return bitmap.White
+		}
+	case bandHigh:
+		if !l.at(img, mx, my+1) {
+			return bitmap.White
+		}
+	}
+	return c
+}
+
+// at returns the color of the module (x, y) in the rendered image.
+func (l Layout) at(img *bitmap.Image, x, y int) bitmap.Color {
+	return img.BinaryAt(x, y) != bitmap.Color(l.Inverted)
+}
+
+const (
+	bandLow = iota - 1
+	bandMiddle
+	bandHigh
+)
+
+// band returns which part of the module the pixel at the offset v from the origin is in.
+// The low and high bands are removed from dark modules next to light modules.
+func (l Layout) band(v int) int {
+	if l.Reduction <= 0 {
+		return bandMiddle
+	}
+	r := min(l.Reduction, l.ModuleSize-1)
+	low := r / 2
+	high := r - low
+	v -= floorDiv(v, l.ModuleSize) * l.ModuleSize
+	switch {
+	case v < low:
+		return bandLow
+	case v >= l.ModuleSize-high:
+		return bandHigh
+	}
+	return bandMiddle
+}
+
+// Row renders the row y of img into row.
+// row[i] is 1 if the pixel (i, y) is black, otherwise 0.
+func (l Layout) Row(img *bitmap.Image, y int, row []uint8) {
+	for x := range row {
+		if l.BinaryAt(img, x+l.Rect.Min.X, y) {
+			row[x] = 1
+		} else {
+			row[x] = 0
+		}
+	}
+}
+
+// Paletted renders img into a 1-bit paletted image.
+func Paletted(img *bitmap.Image, l Layout) *image.Paletted {
+	dst := image.NewPaletted(l.Rect, Palette)
+	fill(dst.Pix, dst.Stride, img, l, 0, 1)
+	return dst
+}
+
+// Gray renders img into a gray image.
+func Gray(img *bitmap.Image, l Layout) *image.Gray {
+	dst := image.NewGray(l.Rect)
+	fill(dst.Pix, dst.Stride, img, l, 0xff, 0x00)
+	return dst
+}
+
+func fill(pix []uint8, stride int, img *bitmap.Image, l Layout, white, black uint8) {
+	dx, dy := l.Rect.Dx(), l.Rect.Dy()
+	for y := 0; y < dy; y++ {
+		row := pix[y*stride : y*stride+dx]
+		if y > 0 && l.sameRow(y-1+l.Rect.Min.Y, y+l.Rect.Min.Y) {
+			copy(row, pix[(y-1)*stride:(y-1)*stride+dx])
+			continue
+		}
+		l.Row(img, y+l.Rect.Min.Y, row)
+		for x, v := range row {
+			if v != 0 {
+				row[x] = black
+			} else {
+				row[x] = white
+			}
+		}
+	}
+}
+
+// sameRow reports whether the pixel rows y0 and y1 are rendered in the same way.
+func (l Layout) sameRow(y0, y1 int) bool {
+	return floorDiv(y0-l.Origin.Y, l.ModuleSize) == floorDiv(y1-l.Origin.Y, l.ModuleSize) &&
+		l.band(y0-l.Origin.Y) == l.band(y1-l.Origin.Y)
+}
+
+func floorDiv(a, b int) int {
+	q := a / b
+	if (a%b != 0) && ((a < 0) != (b < 0)) {
+		q--
+	}
+	return q
+}
+
+// Lazy is a 1-bit image that renders the pixels on demand.
+// It implements [image.PalettedImage].
+type Lazy struct {
+	img    *bitmap.Image
+	layout Layout
+}
+
+// NewLazy returns a lazy image of img.
+func NewLazy(img *bitmap.Image, l Layout) *Lazy {
+	return &Lazy{
+		img:    img,
+		layout: l,
+	}
+}
+
+// ColorModel implements [image.Image].
+func (p *Lazy) ColorModel() color.Model {
+	return Palette
+}
+
+// Bounds implements [image.Image].
+func (p *Lazy) Bounds() image.Rectangle {
+	return p.layout.Rect
+}
+
+// At implements [image.Image].
+func (p *Lazy) At(x, y int) color.Color {
+	return Palette[p.ColorIndexAt(x, y)]
+}
+
+// ColorIndexAt implements [image.PalettedImage].
+func (p *Lazy) ColorIndexAt(x, y int) uint8 {
+	if !image.Pt(x, y).In(p.layout.Rect) {
+		return 0
+	}
+	if p.layout.BinaryAt(p.img, x, y) {
+		return 1
+	}
+	return 0
+}
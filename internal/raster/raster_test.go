@@ -0,0 +1,152 @@
+package raster
+
+import (
+	"image"
+	"testing"
+
+	"github.com/shogo82148/go-imaging/bitmap"
+)
+
+func TestNewLayout(t *testing.T) {
+	tests := []struct {
+		size       image.Point
+		quietZone  int
+		moduleSize float64
+		width      int
+		want       Layout
+	}{
+		{
+			size:       image.Pt(21, 21),
+			quietZone:  4,
+			moduleSize: 1,
+			want: Layout{
+				Rect:       image.Rect(0, 0, 29, 29),
+				Origin:     image.Pt(4, 4),
+				ModuleSize: 1,
+			},
+		},
+		{
+			size:       image.Pt(21, 21),
+			quietZone:  4,
+			moduleSize: 3,
+			want: Layout{
+				Rect:       image.Rect(0, 0, 87, 87),
+				Origin:     image.Pt(12, 12),
+				ModuleSize: 3,
+			},
+		},
+		{
+			// 100 pixels don't fit whole modules.
+			size:       image.Pt(21, 21),
+			quietZone:  4,
+			moduleSize: 1,
+			width:      100,
+			want: Layout{
+				Rect:       image.Rect(0, 0, 100, 100),
+				Origin:     image.Pt(18, 18),
+				ModuleSize: 3,
+			},
+		},
+		{
+			// rMQR: the quiet zone has the same width on all sides.
+			size:       image.Pt(43, 7),
+			quietZone:  2,
+			moduleSize: 2.5,
+			want: Layout{
+				Rect:       image.Rect(0, 0, 118, 46),
+				Origin:     image.Pt(16, 16),
+				ModuleSize: 2,
+			},
+		},
+	}
+	for _, tt := range tests {
+		got := NewLayout(tt.size, tt.quietZone, tt.moduleSize, tt.width)
+		if got != tt.want {
+			t.Errorf("NewLayout(%v, %d, %f, %d) = %v, want %v", tt.size, tt.quietZone, tt.moduleSize, tt.width, got, tt.want)
+		}
+	}
+}
+
+func TestPaletted(t *testing.T) {
+	img := bitmap.New(image.Rect(0, 0, 2, 2))
+	img.SetBinary(0, 0, bitmap.Black)
+	img.SetBinary(1, 1, bitmap.Black)
+
+	l := NewLayout(img.Bounds().Size(), 1, 2, 0)
+	dst := Paletted(img, l)
+	want := []uint8{
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 1, 1, 0, 0, 0, 0,
+		0, 0, 1, 1, 0, 0, 0, 0,
+		0, 0, 0, 0, 1, 1, 0, 0,
+		0, 0, 0, 0, 1, 1, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+	}
+	for i, v := range want {
+		if dst.Pix[i] != v {
+			t.Fatalf("unexpected pixels: got %v, want %v", dst.Pix, want)
+		}
+	}
+
+	gray := Gray(img, l)
+	for i, v := range want {
+		if (gray.Pix[i] == 0) != (v == 1) {
+			t.Fatalf("unexpected pixels: got %v", gray.Pix)
+		}
+	}
+}
+
+func TestInverted(t *testing.T) {
+	img := bitmap.New(image.Rect(0, 0, 2, 2))
+	img.SetBinary(0, 0, bitmap.Black)
+	img.SetBinary(1, 1, bitmap.Black)
+
+	l := NewLayout(img.Bounds().Size(), 1, 1, 0)
+	l.Inverted = true
+	dst := Paletted(img, l)
+	want := []uint8{
+		1, 1, 1, 1,
+		1, 0, 1, 1,
+		1, 1, 0, 1,
+		1, 1, 1, 1,
+	}
+	for i, v := range want {
+		if dst.Pix[i] != v {
+			t.Fatalf("unexpected pixels: got %v, want %v", dst.Pix, want)
+		}
+	}
+}
+
+func TestReduction(t *testing.T) {
+	// dark, dark, light
+	// light, dark, light
+	img := bitmap.New(image.Rect(0, 0, 3, 2))
+	img.SetBinary(0, 0, bitmap.Black)
+	img.SetBinary(1, 0, bitmap.Black)
+	img.SetBinary(1, 1, bitmap.Black)
+
+	l := NewLayout(img.Bounds().Size(), 0, 4, 0)
+	l.Reduction = 3 // 1 pixel from the low side, 2 pixels from the high side.
+	dst := Paletted(img, l)
+
+	want := []string{
+		"............",
+		".11111......",
+		"....11......",
+		"....11......",
+		".....1......",
+		".....1......",
+		"............",
+		"............",
+	}
+	for y, line := range want {
+		for x, c := range line {
+			got := dst.ColorIndexAt(x, y) == 1
+			if got != (c != '.') {
+				t.Errorf("pixel (%d, %d): got %v, want %v", x, y, got, c != '.')
+			}
+		}
+	}
+}
@@ -0,0 +1,94 @@
+package raster
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"image"
+	"io"
+	"strings"
+
+	"github.com/shogo82148/go-imaging/bitmap"
+)
+
+// EncodeText writes img as text to w.
+// Each module is written as black or white, and each row of modules is terminated by a newline.
+// The quiet zone of quietZone modules is written around the symbol.
+func EncodeText(w io.Writer, img *bitmap.Image, quietZone int, black, white string) error {
+	bw := bufio.NewWriter(w)
+	bounds := img.Bounds()
+	for y := bounds.Min.Y - quietZone; y < bounds.Max.Y+quietZone; y++ {
+		for x := bounds.Min.X - quietZone; x < bounds.Max.X+quietZone; x++ {
+			if img.BinaryAt(x, y) {
+				bw.WriteString(black)
+			} else {
+				bw.WriteString(white)
+			}
+		}
+		bw.WriteByte('\n')
+	}
+	return bw.Flush()
+}
+
+// DecodeText reads text written by [EncodeText] from r.
+// Each line is split into the groups of characters that equal to black or white.
+// Trailing white spaces may be omitted, and the short lines are padded with white modules.
+func DecodeText(r io.Reader, black, white string) (*bitmap.Image, error) {
+	if black == "" || white == "" {
+		return nil, errors.New("raster: empty black or white string")
+	}
+	if black == white {
+		return nil, errors.New("raster: black and white are the same")
+	}
+
+	var rows [][]bool
+	width := 0
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		row, err := splitText(strings.TrimRight(s.Text(), "\r"), black, white)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, row)
+		width = max(width, len(row))
+	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+	if width == 0 || len(rows) == 0 {
+		return nil, errors.New("raster: empty text")
+	}
+
+	img := bitmap.New(image.Rect(0, 0, width, len(rows)))
+	for y, row := range rows {
+		for x, v := range row {
+			img.SetBinary(x, y, bitmap.Color(v))
+		}
+	}
+	return img, nil
+}
+
+// splitText splits line into the modules, which are true for black.
+// The longer string is matched first, because one may be a prefix of the other.
+// The rest of line that is a prefix of white is the trailing white module whose spaces are removed.
+func splitText(line, black, white string) ([]bool, error) {
+	var row []bool
+	for line != "" {
+		b := strings.HasPrefix(line, black)
+		w := strings.HasPrefix(line, white)
+		switch {
+		case b && (!w || len(black) >= len(white)):
+			row = append(row, true)
+			line = line[len(black):]
+		case w:
+			row = append(row, false)
+			line = line[len(white):]
+		case strings.HasPrefix(white, line):
+			row = append(row, false)
+			line = ""
+		default:
+			return nil, fmt.Errorf("raster: unexpected text: %q", line)
+		}
+	}
+	return row, nil
+}
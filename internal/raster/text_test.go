@@ -0,0 +1,98 @@
+package raster
+
+import (
+	"bytes"
+	"image"
+	"strings"
+	"testing"
+
+	"github.com/shogo82148/go-imaging/bitmap"
+)
+
+func TestDecodeText(t *testing.T) {
+	tests := []struct {
+		input        string
+		black, white string
+		want         []string
+	}{
+		{"# #\n #\n", "#", " ", []string{"101", "010"}},
+		{"██  ██\n  ██\n", "██", "  ", []string{"101", "010"}},
+
+		// the strings have different lengths.
+		{"#..#\n..#\n", "#", "..", []string{"101", "010"}},
+
+		// the trailing spaces are removed.
+		{"#  #\n  # \n", "#", "  ", []string{"101", "010"}},
+	}
+	for _, tt := range tests {
+		img, err := DecodeText(strings.NewReader(tt.input), tt.black, tt.white)
+		if err != nil {
+			t.Errorf("%q: %v", tt.input, err)
+			continue
+		}
+		if got, want := img.Bounds(), image.Rect(0, 0, len(tt.want[0]), len(tt.want)); got != want {
+			t.Errorf("%q: got bounds %v, want %v", tt.input, got, want)
+			continue
+		}
+		for y, row := range tt.want {
+			for x, c := range row {
+				if got := img.BinaryAt(x, y); got != bitmap.Color(c == '1') {
+					t.Errorf("%q: module (%d, %d) mismatch", tt.input, x, y)
+				}
+			}
+		}
+	}
+}
+
+func TestDecodeText_Error(t *testing.T) {
+	tests := []struct {
+		input        string
+		black, white string
+	}{
+		{"#?#\n", "#", " "},
+		{"#\n", "#", "#"},
+		{"#\n", "", " "},
+	}
+	for _, tt := range tests {
+		if _, err := DecodeText(strings.NewReader(tt.input), tt.black, tt.white); err == nil {
+			t.Errorf("%q, %q, %q: want error, got nil", tt.input, tt.black, tt.white)
+		}
+	}
+}
+
+func TestTrim_Inverted(t *testing.T) {
+	// a finder pattern like symbol, written with the swapped characters.
+	img := bitmap.New(image.Rect(0, 0, 9, 7))
+	for y := 0; y < 7; y++ {
+		for x := 0; x < 7; x++ {
+			d := max(abs(x-3), abs(y-3))
+			img.SetBinary(x, y, bitmap.Color(d != 2))
+		}
+	}
+	img.SetBinary(8, 0, bitmap.Black)
+	img.SetBinary(8, 6, bitmap.Black)
+	var buf bytes.Buffer
+	if err := EncodeText(&buf, img, 2, " ", "#"); err != nil {
+		t.Fatal(err)
+	}
+
+	// the symbol is inverted in the black quiet zone, and it is kept inverted.
+	raw, err := DecodeText(&buf, "#", " ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := Trim(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Bounds() != img.Bounds() {
+		t.Fatalf("unexpected bounds: got %v, want %v", got.Bounds(), img.Bounds())
+	}
+	for y := 0; y < 7; y++ {
+		for x := 0; x < 9; x++ {
+			if got.BinaryAt(x, y) == img.BinaryAt(x, y) {
+				t.Errorf("module (%d, %d) mismatch", x, y)
+			}
+		}
+	}
+}
@@ -0,0 +1,43 @@
+package raster
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+
+	"github.com/shogo82148/go-imaging/bitmap"
+)
+
+// EncodeZPL writes img as a ZPL label to w.
+// The image is embedded as a ^GF graphic field in the ASCII hexadecimal format.
+// The pixels are the printer dots.
+func EncodeZPL(w io.Writer, img *bitmap.Image, l Layout) error {
+	dx, dy := l.Rect.Dx(), l.Rect.Dy()
+	bytesPerRow := (dx + 7) / 8
+	total := bytesPerRow * dy
+
+	bw := bufio.NewWriter(w)
+	fmt.Fprintf(bw, "^XA\n^FO0,0^GFA,%d,%d,%d,\n", total, total, bytesPerRow)
+
+	const hex = "0123456789ABCDEF"
+	row := make([]uint8, dx)
+	packed := make([]uint8, bytesPerRow)
+	for y := 0; y < dy; y++ {
+		l.Row(img, y+l.Rect.Min.Y, row)
+		for i := range packed {
+			packed[i] = 0
+		}
+		for x, v := range row {
+			if v != 0 {
+				packed[x/8] |= 0x80 >> (x % 8)
+			}
+		}
+		for _, b := range packed {
+			bw.WriteByte(hex[b>>4])
+			bw.WriteByte(hex[b&0x0f])
+		}
+		bw.WriteByte('\n')
+	}
+	bw.WriteString("^FS\n^XZ\n")
+	return bw.Flush()
+}
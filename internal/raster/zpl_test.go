@@ -0,0 +1,30 @@
+package raster
+
+import (
+	"bytes"
+	"image"
+	"testing"
+
+	"github.com/shogo82148/go-imaging/bitmap"
+)
+
+func TestEncodeZPL(t *testing.T) {
+	img := bitmap.New(image.Rect(0, 0, 5, 2))
+	img.SetBinary(0, 0, bitmap.Black)
+	img.SetBinary(4, 1, bitmap.Black)
+	l := NewLayout(img.Bounds().Size(), 0, 2, 0)
+
+	var buf bytes.Buffer
+	if err := EncodeZPL(&buf, img, l); err != nil {
+		t.Fatal(err)
+	}
+	want := "^XA\n^FO0,0^GFA,8,8,2,\n" +
+		"C000\n" +
+		"C000\n" +
+		"00C0\n" +
+		"00C0\n" +
+		"^FS\n^XZ\n"
+	if got := buf.String(); got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
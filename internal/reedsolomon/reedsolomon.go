@@ -1,6 +1,7 @@
 package reedsolomon
 
 import (
+	"errors"
 	"fmt"
 	"hash"
 
@@ -39,6 +40,9 @@ func Decode(data []byte, twoS int) error {
 		return fmt.Errorf("reedsolomon: failed to decode: %w", err)
 	}
 	errorLocations := findErrorLocations(sigma)
+	if len(errorLocations) != sigma.Degree() {
+		return errors.New("reedsolomon: error locator degree does not match number of roots")
+	}
 	errorMagnitudes := findErrorMagnitudes(omega, errorLocations)
 
 	for i := range errorLocations {
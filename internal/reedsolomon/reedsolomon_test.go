@@ -138,3 +138,18 @@ func TestDecode_FailCorrection(t *testing.T) {
 		t.Error("want error, but not")
 	}
 }
+
+func TestDecode_TooManyErrors(t *testing.T) {
+	w := New(17)
+	data := []byte{0x20, 0x2d, 0xc8, 0x20, 0x00, 0xec, 0x11, 0xec, 0x11}
+	w.Write(data)
+	data = append(data, w.Sum(nil)...)
+
+	// the error locator has fewer roots than its degree.
+	for i := 0; i < 12; i++ {
+		data[i] ^= 0xff
+	}
+	if err := Decode(data, 17); err == nil {
+		t.Error("want error, but not")
+	}
+}
@@ -0,0 +1,250 @@
+// Package sampling samples the modules of a captured symbol on a piecewise grid,
+// which follows the warp of the symbol printed on curved or wrinkled surfaces.
+package sampling
+
+import (
+	"image"
+	"math"
+)
+
+// Point is a point in the image.
+type Point struct {
+	X, Y float64
+}
+
+// Add returns p+q.
+func (p Point) Add(q Point) Point {
+	return Point{p.X + q.X, p.Y + q.Y}
+}
+
+// Sub returns p-q.
+func (p Point) Sub(q Point) Point {
+	return Point{p.X - q.X, p.Y - q.Y}
+}
+
+// Mul returns p*k.
+func (p Point) Mul(k float64) Point {
+	return Point{p.X * k, p.Y * k}
+}
+
+// lerp returns the point that divides p and q in the ratio of t:(1-t).
+func lerp(p, q Point, t float64) Point {
+	return p.Add(q.Sub(p).Mul(t))
+}
+
+// Quad is a quadrilateral with the corners at the top left, the top right,
+// the bottom right and the bottom left in this order.
+type Quad [4]Point
+
+// Map returns the point at (u, v) in the quadrilateral,
+// where (0, 0) is the top left corner and (1, 1) is the bottom right corner.
+func (q Quad) Map(u, v float64) Point {
+	return lerp(lerp(q[0], q[1], u), lerp(q[3], q[2], u), v)
+}
+
+// Center returns the center of q.
+func (q Quad) Center() Point {
+	return q.Map(0.5, 0.5)
+}
+
+// Size returns the average width and height of q.
+func (q Quad) Size() Point {
+	top, bottom := q[1].Sub(q[0]), q[2].Sub(q[3])
+	left, right := q[3].Sub(q[0]), q[2].Sub(q[1])
+	return Point{
+		X: (math.Hypot(top.X, top.Y) + math.Hypot(bottom.X, bottom.Y)) / 2,
+		Y: (math.Hypot(left.X, left.Y) + math.Hypot(right.X, right.Y)) / 2,
+	}
+}
+
+// Grid maps the module coordinates to the image coordinates.
+// It has the control points at the module centers of the lattice Xs x Ys,
+// and interpolates them bilinearly in each cell of the lattice.
+type Grid struct {
+	Cols, Rows int
+
+	// Xs and Ys are the module coordinates of the lattice in ascending order.
+	// They must have at least two elements.
+	Xs, Ys []int
+
+	// Points is the image coordinates of the module centers of the lattice in row-major order.
+	Points []Point
+
+	// Known reports whether the control point is set.
+	Known []bool
+
+	// TX and TY are the effective coordinates of the columns and the rows.
+	// They are measured on the timing patterns, and correct the non-uniformity in the cells.
+	TX, TY []float64
+
+	// Fallback returns the image coordinates of the module center (x, y)
+	// if they can't be predicted from the other control points.
+	Fallback func(x, y int) Point
+}
+
+// NewGrid returns a new grid of cols x rows modules with the lattice xs x ys.
+func NewGrid(cols, rows int, xs, ys []int) *Grid {
+	g := &Grid{
+		Cols:   cols,
+		Rows:   rows,
+		Xs:     xs,
+		Ys:     ys,
+		Points: make([]Point, len(xs)*len(ys)),
+		Known:  make([]bool, len(xs)*len(ys)),
+		TX:     make([]float64, cols),
+		TY:     make([]float64, rows),
+	}
+	for i := range g.TX {
+		g.TX[i] = float64(i)
+	}
+	for i := range g.TY {
+		g.TY[i] = float64(i)
+	}
+	return g
+}
+
+// Set sets the control point (i, j) of the lattice.
+func (g *Grid) Set(i, j int, p Point) {
+	g.Points[j*len(g.Xs)+i] = p
+	g.Known[j*len(g.Xs)+i] = true
+}
+
+// At returns the control point (i, j) of the lattice.
+func (g *Grid) At(i, j int) (Point, bool) {
+	if i < 0 || j < 0 || i >= len(g.Xs) || j >= len(g.Ys) {
+		return Point{}, false
+	}
+	return g.Points[j*len(g.Xs)+i], g.Known[j*len(g.Xs)+i]
+}
+
+// Predict predicts the control point (i, j) from the known control points around it,
+// assuming that the neighboring cells are parallelograms.
+// If it is not possible, the point is interpolated from the known points in the same row or column.
+func (g *Grid) Predict(i, j int) (Point, bool) {
+	for _, d := range [][2]int{{-1, -1}, {1, -1}, {-1, 1}, {1, 1}} {
+		a, ok1 := g.At(i+d[0], j)
+		b, ok2 := g.At(i, j+d[1])
+		c, ok3 := g.At(i+d[0], j+d[1])
+		if ok1 && ok2 && ok3 {
+			return a.Add(b).Sub(c), true
+		}
+	}
+
+	// interpolate in the row
+	for i0 := i - 1; i0 >= 0; i0-- {
+		p0, ok := g.At(i0, j)
+		if !ok {
+			continue
+		}
+		for i1 := i + 1; i1 < len(g.Xs); i1++ {
+			if p1, ok := g.At(i1, j); ok {
+				t := float64(g.Xs[i]-g.Xs[i0]) / float64(g.Xs[i1]-g.Xs[i0])
+				return lerp(p0, p1, t), true
+			}
+		}
+		break
+	}
+
+	// interpolate in the column
+	for j0 := j - 1; j0 >= 0; j0-- {
+		p0, ok := g.At(i, j0)
+		if !ok {
+			continue
+		}
+		for j1 := j + 1; j1 < len(g.Ys); j1++ {
+			if p1, ok := g.At(i, j1); ok {
+				t := float64(g.Ys[j]-g.Ys[j0]) / float64(g.Ys[j1]-g.Ys[j0])
+				return lerp(p0, p1, t), true
+			}
+		}
+		break
+	}
+	return Point{}, false
+}
+
+// Fill predicts all unknown control points.
+func (g *Grid) Fill() {
+	for {
+		updated := false
+		for j := range g.Ys {
+			for i := range g.Xs {
+				if _, ok := g.At(i, j); ok {
+					continue
+				}
+				if p, ok := g.Predict(i, j); ok {
+					g.Set(i, j, p)
+					updated = true
+				}
+			}
+		}
+		if !updated {
+			break
+		}
+	}
+	for j, y := range g.Ys {
+		for i, x := range g.Xs {
+			if _, ok := g.At(i, j); !ok {
+				g.Set(i, j, g.Fallback(x, y))
+			}
+		}
+	}
+}
+
+// cell returns the index of the lattice cell that contains v.
+func cell(lattice []int, v int) int {
+	for i := 1; i < len(lattice)-1; i++ {
+		if v < lattice[i] {
+			return i - 1
+		}
+	}
+	return len(lattice) - 2
+}
+
+// Map returns the image coordinates of the point (x, y) in the module coordinates,
+// where the integer coordinates are the module centers.
+func (g *Grid) Map(x, y float64) Point {
+	ix, iy := int(math.Floor(x+0.5)), int(math.Floor(y+0.5))
+	tx, ty := x, y
+	if ix >= 0 && ix < g.Cols {
+		tx += g.TX[ix] - float64(ix)
+	}
+	if iy >= 0 && iy < g.Rows {
+		ty += g.TY[iy] - float64(iy)
+	}
+
+	i, j := cell(g.Xs, ix), cell(g.Ys, iy)
+	x0, x1 := float64(g.Xs[i]), float64(g.Xs[i+1])
+	y0, y1 := float64(g.Ys[j]), float64(g.Ys[j+1])
+	p00, _ := g.At(i, j)
+	p10, _ := g.At(i+1, j)
+	p01, _ := g.At(i, j+1)
+	p11, _ := g.At(i+1, j+1)
+	u, v := (tx-x0)/(x1-x0), (ty-y0)/(y1-y0)
+	return lerp(lerp(p00, p10, u), lerp(p01, p11, u), v)
+}
+
+// Bounds returns the bounds of the modules in the image.
+// The edges of the symbol are mapped at every module, because they may be curved.
+func (g *Grid) Bounds() image.Rectangle {
+	minX, minY := math.Inf(1), math.Inf(1)
+	maxX, maxY := math.Inf(-1), math.Inf(-1)
+	add := func(x, y float64) {
+		p := g.Map(x, y)
+		minX, minY = min(minX, p.X), min(minY, p.Y)
+		maxX, maxY = max(maxX, p.X), max(maxY, p.Y)
+	}
+	left, right := -0.5, float64(g.Cols)-0.5
+	top, bottom := -0.5, float64(g.Rows)-0.5
+	for x := 0; x <= g.Cols; x++ {
+		add(float64(x)-0.5, top)
+		add(float64(x)-0.5, bottom)
+	}
+	for y := 0; y <= g.Rows; y++ {
+		add(left, float64(y)-0.5)
+		add(right, float64(y)-0.5)
+	}
+	return image.Rect(
+		int(math.Floor(minX)), int(math.Floor(minY)),
+		int(math.Ceil(maxX)), int(math.Ceil(maxY)),
+	)
+}
@@ -0,0 +1,79 @@
+package sampling
+
+import (
+	"image"
+	"math"
+	"testing"
+)
+
+func near(p, q Point) bool {
+	return math.Abs(p.X-q.X) < 1e-9 && math.Abs(p.Y-q.Y) < 1e-9
+}
+
+func TestGrid_Map(t *testing.T) {
+	// the module (x, y) is at (10x+5, 20y+5).
+	ideal := func(x, y float64) Point {
+		return Point{10*x + 5, 20*y + 5}
+	}
+	g := NewGrid(21, 21, []int{0, 6, 20}, []int{0, 6, 20})
+	g.Fallback = func(x, y int) Point {
+		return ideal(float64(x), float64(y))
+	}
+	g.Set(0, 0, ideal(0, 0))
+	g.Set(1, 0, ideal(6, 0))
+	g.Set(0, 1, ideal(0, 6))
+	g.Set(1, 1, ideal(6, 6))
+	g.Set(2, 2, ideal(20, 20))
+	g.Fill()
+
+	for _, pt := range [][2]float64{{0, 0}, {3, 3}, {6.25, 10}, {13, 2}, {20, 20}} {
+		got, want := g.Map(pt[0], pt[1]), ideal(pt[0], pt[1])
+		if !near(got, want) {
+			t.Errorf("Map(%v, %v): got %v, want %v", pt[0], pt[1], got, want)
+		}
+	}
+}
+
+func TestGrid_Bounds(t *testing.T) {
+	// the module (x, y) is at (10x+5, 20y+10).
+	g := NewGrid(21, 21, []int{0, 20}, []int{0, 20})
+	g.Set(0, 0, Point{5, 10})
+	g.Set(1, 0, Point{205, 10})
+	g.Set(0, 1, Point{5, 410})
+	g.Set(1, 1, Point{205, 410})
+
+	if got, want := g.Bounds(), image.Rect(0, 0, 210, 420); got != want {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestGrid_Predict(t *testing.T) {
+	g := NewGrid(21, 21, []int{0, 10, 20}, []int{0, 10, 20})
+	g.Set(0, 0, Point{0, 0})
+	g.Set(1, 0, Point{10, 1})
+	g.Set(0, 1, Point{1, 10})
+
+	// parallelogram
+	got, ok := g.Predict(1, 1)
+	if !ok || !near(got, Point{11, 11}) {
+		t.Errorf("got %v, %v, want {11 11}, true", got, ok)
+	}
+
+	// no neighbors
+	if _, ok := g.Predict(2, 2); ok {
+		t.Error("want false, got true")
+	}
+}
+
+func TestQuad(t *testing.T) {
+	q := Quad{{0, 0}, {70, 0}, {70, 35}, {0, 35}}
+	if got := q.Center(); !near(got, Point{35, 17.5}) {
+		t.Errorf("Center: got %v", got)
+	}
+	if got := q.Size(); !near(got, Point{70, 35}) {
+		t.Errorf("Size: got %v", got)
+	}
+	if got := q.Map(0.5/7, 0.5/7); !near(got, Point{5, 2.5}) {
+		t.Errorf("Map: got %v", got)
+	}
+}
@@ -0,0 +1,315 @@
+package sampling
+
+import (
+	"image"
+	"math"
+
+	"github.com/shogo82148/go-imaging/bitmap"
+	"github.com/shogo82148/qrcode/internal/grading"
+)
+
+// Sampler samples the modules of a captured image.
+type Sampler struct {
+	Image *grading.Image
+
+	// Threshold is the global threshold between the dark and the light pixels.
+	Threshold float64
+}
+
+// New returns a new sampler of img.
+func New(img *grading.Image) *Sampler {
+	s := &Sampler{
+		Image: img,
+	}
+	if len(img.Pix) > 0 {
+		lo, hi := img.Pix[0], img.Pix[0]
+		for _, v := range img.Pix {
+			lo = min(lo, v)
+			hi = max(hi, v)
+		}
+		s.Threshold = (lo + hi) / 2
+	}
+	return s
+}
+
+// Dark reports whether the pixel at (x, y) is dark.
+func (s *Sampler) Dark(x, y int) bool {
+	return s.Image.At(x, y) < s.Threshold
+}
+
+// DarkAt reports whether the pixel at p is dark.
+func (s *Sampler) DarkAt(p Point) bool {
+	return s.Dark(int(math.Floor(p.X)), int(math.Floor(p.Y)))
+}
+
+// Blob finds the connected pixels of the color dark nearest to p within the radius r,
+// and returns their centroid and bounds.
+// ok is false if there are no such pixels, or they have more than maxArea pixels.
+func (s *Sampler) Blob(p Point, r float64, dark bool, maxArea int) (centroid Point, bounds image.Rectangle, ok bool) {
+	pixels, ok := s.blob(p, r, dark, maxArea)
+	if !ok {
+		return Point{}, image.Rectangle{}, false
+	}
+	var sx, sy float64
+	bounds = image.Rectangle{Min: pixels[0], Max: pixels[0].Add(image.Pt(1, 1))}
+	for _, q := range pixels {
+		sx += float64(q.X) + 0.5
+		sy += float64(q.Y) + 0.5
+		bounds = bounds.Union(image.Rectangle{Min: q, Max: q.Add(image.Pt(1, 1))})
+	}
+	n := float64(len(pixels))
+	return Point{sx / n, sy / n}, bounds, true
+}
+
+// Quad finds the connected pixels of the color dark nearest to p within the radius r,
+// and returns their outer corners.
+// ok is false if there are no such pixels, or they have more than maxArea pixels.
+func (s *Sampler) Quad(p Point, r float64, dark bool, maxArea int) (Quad, bool) {
+	pixels, ok := s.blob(p, r, dark, maxArea)
+	if !ok {
+		return Quad{}, false
+	}
+
+	// the corners are the extreme pixels in the diagonal directions.
+	var tl, tr, br, bl image.Point
+	for i, q := range pixels {
+		if i == 0 || q.X+q.Y < tl.X+tl.Y {
+			tl = q
+		}
+		if i == 0 || q.X-q.Y > tr.X-tr.Y {
+			tr = q
+		}
+		if i == 0 || q.X+q.Y > br.X+br.Y {
+			br = q
+		}
+		if i == 0 || q.X-q.Y < bl.X-bl.Y {
+			bl = q
+		}
+	}
+	return Quad{
+		{float64(tl.X), float64(tl.Y)},
+		{float64(tr.X + 1), float64(tr.Y)},
+		{float64(br.X + 1), float64(br.Y + 1)},
+		{float64(bl.X), float64(bl.Y + 1)},
+	}, true
+}
+
+// blob finds the connected pixels of the color dark nearest to p within the radius r.
+func (s *Sampler) blob(p Point, r float64, dark bool, maxArea int) ([]image.Point, bool) {
+	// search the nearest pixel
+	start := image.Point{}
+	found := false
+	best := r * r
+	for y := int(math.Floor(p.Y - r)); y <= int(math.Ceil(p.Y+r)); y++ {
+		for x := int(math.Floor(p.X - r)); x <= int(math.Ceil(p.X+r)); x++ {
+			if !image.Pt(x, y).In(s.Image.Rect) || s.Dark(x, y) != dark {
+				continue
+			}
+			dx, dy := float64(x)+0.5-p.X, float64(y)+0.5-p.Y
+			if d := dx*dx + dy*dy; d <= best {
+				best = d
+				start = image.Pt(x, y)
+				found = true
+			}
+		}
+	}
+	if !found {
+		return nil, false
+	}
+
+	// flood fill
+	visited := map[image.Point]bool{start: true}
+	pixels := []image.Point{start}
+	for i := 0; i < len(pixels); i++ {
+		if len(pixels) > maxArea {
+			return nil, false
+		}
+		q := pixels[i]
+		for _, d := range []image.Point{{1, 0}, {-1, 0}, {0, 1}, {0, -1}} {
+			n := q.Add(d)
+			if visited[n] || !n.In(s.Image.Rect) || s.Dark(n.X, n.Y) != dark {
+				continue
+			}
+			visited[n] = true
+			pixels = append(pixels, n)
+		}
+	}
+	return pixels, true
+}
+
+// Finder finds the finder pattern nearest to p within the radius r,
+// as the connected dark pixels of its outer ring of 7 x 7 modules.
+// It returns the outer corners of the ring.
+func (s *Sampler) Finder(p Point, r float64, maxArea int) (Quad, bool) {
+	q, ok := s.Quad(p, r, true, maxArea)
+	if !ok {
+		return Quad{}, false
+	}
+	if size := q.Size(); size.X < 7 || size.Y < 7 {
+		return Quad{}, false
+	}
+	return q, true
+}
+
+// Sample samples the modules on g.
+// Each module is sampled at 3 x 3 points in it, and it is dark if the majority of them are dark.
+func (s *Sampler) Sample(g *Grid) *bitmap.Image {
+	img := bitmap.New(image.Rect(0, 0, g.Cols, g.Rows))
+	offsets := []float64{-0.25, 0, 0.25}
+	for y := 0; y < g.Rows; y++ {
+		for x := 0; x < g.Cols; x++ {
+			votes := 0
+			for _, dy := range offsets {
+				for _, dx := range offsets {
+					if s.DarkAt(g.Map(float64(x)+dx, float64(y)+dy)) {
+						votes++
+					}
+				}
+			}
+			img.SetBinary(x, y, votes >= 5)
+		}
+	}
+	return img
+}
+
+// TimingX measures the module centers of the horizontal timing pattern on the row y from x0 to x1,
+// and corrects g.TX by them.
+// ideal reports whether the module should be dark.
+// Only the modules whose both neighbors have the other color are measured.
+func (s *Sampler) TimingX(g *Grid, y, x0, x1 int, ideal func(x, y int) bool) {
+	delta := make(map[int]float64)
+	for x := x0; x <= x1; x++ {
+		c := ideal(x, y)
+		if ideal(x-1, y) == c || ideal(x+1, y) == c {
+			continue
+		}
+		prev := g.Map(float64(x-1), float64(y))
+		center := g.Map(float64(x), float64(y))
+		next := g.Map(float64(x+1), float64(y))
+		if d, ok := s.offset(prev, center, next); ok {
+			delta[x] = d
+		}
+	}
+	correct(g.TX, g.Xs, x0, x1, delta)
+}
+
+// TimingY measures the module centers of the vertical timing pattern on the column x from y0 to y1,
+// and corrects g.TY by them.
+// ideal reports whether the module should be dark.
+// Only the modules whose both neighbors have the other color are measured.
+func (s *Sampler) TimingY(g *Grid, x, y0, y1 int, ideal func(x, y int) bool) {
+	delta := make(map[int]float64)
+	for y := y0; y <= y1; y++ {
+		c := ideal(x, y)
+		if ideal(x, y-1) == c || ideal(x, y+1) == c {
+			continue
+		}
+		prev := g.Map(float64(x), float64(y-1))
+		center := g.Map(float64(x), float64(y))
+		next := g.Map(float64(x), float64(y+1))
+		if d, ok := s.offset(prev, center, next); ok {
+			delta[y] = d
+		}
+	}
+	correct(g.TY, g.Ys, y0, y1, delta)
+}
+
+// correct adds the measured offsets delta to t from v0 to v1.
+// The offsets of the modules that are not measured are interpolated from the nearest measured ones.
+//
+// The control points on the lattice are already fitted to the symbol,
+// so the offsets there are caused by the shear of the row or the column where they are measured.
+// The linear component of the offsets in each cell of the lattice is removed,
+// and only the non-uniformity in the cell is corrected.
+func correct(t []float64, lattice []int, v0, v1 int, delta map[int]float64) {
+	if len(delta) == 0 {
+		return
+	}
+
+	// interpolate the offsets
+	full := make(map[int]float64, v1-v0+1)
+	prev := -1
+	for v := v0; v <= v1; v++ {
+		if d, ok := delta[v]; ok {
+			full[v] = d
+			prev = v
+			continue
+		}
+		next := -1
+		for w := v + 1; w <= v1; w++ {
+			if _, ok := delta[w]; ok {
+				next = w
+				break
+			}
+		}
+		switch {
+		case prev >= 0 && next >= 0:
+			k := float64(v-prev) / float64(next-prev)
+			full[v] = delta[prev] + (delta[next]-delta[prev])*k
+		case prev >= 0:
+			full[v] = delta[prev]
+		case next >= 0:
+			full[v] = delta[next]
+		}
+	}
+
+	// remove the linear component in each cell
+	for i := 0; i+1 < len(lattice); i++ {
+		a, b := lattice[i], lattice[i+1]
+		da := full[min(max(a, v0), v1)]
+		db := full[min(max(b, v0), v1)]
+		for v := max(a, v0); v <= min(b, v1); v++ {
+			k := float64(v-a) / float64(b-a)
+			t[v] += full[v] - (da + (db-da)*k)
+		}
+	}
+}
+
+// offset returns the offset of the measured module center from center in modules.
+// The module is between the edges on the segments prev-center and center-next.
+func (s *Sampler) offset(prev, center, next Point) (float64, bool) {
+	e1, ok1 := s.edge(prev, center)
+	e2, ok2 := s.edge(center, next)
+	if !ok1 || !ok2 {
+		return 0, false
+	}
+	m := lerp(e1, e2, 0.5)
+	d := next.Sub(prev).Mul(0.5)
+	l := d.X*d.X + d.Y*d.Y
+	if l == 0 {
+		return 0, false
+	}
+	v := m.Sub(center)
+	delta := (v.X*d.X + v.Y*d.Y) / l
+	return min(max(delta, -0.5), 0.5), true
+}
+
+// edge finds the crossing of the threshold on the segment p-q nearest to its midpoint.
+func (s *Sampler) edge(p, q Point) (Point, bool) {
+	d := q.Sub(p)
+	n := int(math.Ceil(math.Hypot(d.X, d.Y)))
+	if n == 0 {
+		return Point{}, false
+	}
+	value := func(i int) float64 {
+		pt := lerp(p, q, float64(i)/float64(n))
+		return s.Image.At(int(math.Floor(pt.X)), int(math.Floor(pt.Y))) - s.Threshold
+	}
+	best, found := 0.0, false
+	v0 := value(0)
+	for i := 1; i <= n; i++ {
+		v1 := value(i)
+		if (v0 < 0) != (v1 < 0) {
+			t := (float64(i-1) + v0/(v0-v1)) / float64(n)
+			if !found || math.Abs(t-0.5) < math.Abs(best-0.5) {
+				best = t
+				found = true
+			}
+		}
+		v0 = v1
+	}
+	if !found {
+		return Point{}, false
+	}
+	return lerp(p, q, best), true
+}
@@ -0,0 +1,285 @@
+package sampling
+
+import (
+	"image"
+	"math"
+
+	"github.com/shogo82148/go-imaging/bitmap"
+)
+
+// Scale is the module boundaries along an axis of an axis-aligned symbol.
+// The boundary k is at Origin + k*Pitch, and the symbol has Modules modules along the axis.
+// The pixel i covers from i to i+1.
+type Scale struct {
+	Origin, Pitch float64
+	Modules       int
+}
+
+// FinderScales fits the scales to the pixels on the middle row and the middle column
+// of the finder pattern at the top left corner of rect.
+// run is the rough size of the finder pattern.
+// The origins and the pitches are fitted to the sub-pixel accuracy, and Modules is 7.
+func (s *Sampler) FinderScales(rect image.Rectangle, run image.Point) (x, y Scale, ok bool) {
+	r := s.Image.Rect
+	px, py := float64(run.X)/7, float64(run.Y)/7
+	row := make([]float64, r.Dx())
+	for i := range row {
+		row[i] = s.Image.At(r.Min.X+i, int(math.Floor(float64(rect.Min.Y)+3.5*py)))
+	}
+	column := make([]float64, r.Dy())
+	for i := range column {
+		column[i] = s.Image.At(int(math.Floor(float64(rect.Min.X)+3.5*px)), r.Min.Y+i)
+	}
+	x, ok1 := fitFinder(row, r.Min.X, float64(rect.Min.X), px)
+	y, ok2 := fitFinder(column, r.Min.Y, float64(rect.Min.Y), py)
+	return x, y, ok1 && ok2
+}
+
+// fitFinder fits the scale to the pixels on the middle row or column of the finder pattern,
+// which are the modules "#.###.#" between the light quiet zone and the light separator.
+// line[i] is the pixel at start+i, and origin and pitch are the rough scale.
+func fitFinder(line []float64, start int, origin, pitch float64) (Scale, bool) {
+	s := Scale{Origin: origin, Pitch: pitch, Modules: 7}
+	dark := func(k int) bool { return k != 1 && k != 5 }
+	return fitLine(line, start, s, dark, 0, 0.05, 0.01*pitch, []int{30, 10, 10, 10})
+}
+
+// TimingScaleX refines the scale x by the pixels on the middle of the module row y,
+// which is the horizontal timing pattern or another row of the known modules.
+// ideal reports whether the module should be dark.
+// The pitch fitted to the finder pattern is inaccurate over the whole symbol,
+// because the error of the pitch is multiplied by the number of the modules.
+func (s *Sampler) TimingScaleX(x, y Scale, row int, ideal func(x, y int) bool) Scale {
+	r := s.Image.Rect
+	line := make([]float64, r.Dx())
+	py := int(math.Floor(y.Origin + (float64(row)+0.5)*y.Pitch))
+	for i := range line {
+		line[i] = s.Image.At(r.Min.X+i, py)
+	}
+	dark := func(k int) bool { return ideal(k, row) }
+	if fitted, ok := fitLine(line, r.Min.X, x, dark, 2, 0.05, 0.001*x.Pitch, []int{10, 10, 10}); ok {
+		return fitted
+	}
+	return x
+}
+
+// TimingScaleY refines the scale y by the pixels on the middle of the module column x,
+// which is the vertical timing pattern or another column of the known modules.
+// ideal reports whether the module should be dark.
+func (s *Sampler) TimingScaleY(x, y Scale, column int, ideal func(x, y int) bool) Scale {
+	r := s.Image.Rect
+	line := make([]float64, r.Dy())
+	px := int(math.Floor(x.Origin + (float64(column)+0.5)*x.Pitch))
+	for i := range line {
+		line[i] = s.Image.At(px, r.Min.Y+i)
+	}
+	dark := func(k int) bool { return ideal(column, k) }
+	if fitted, ok := fitLine(line, r.Min.Y, y, dark, 2, 0.05, 0.001*y.Pitch, []int{10, 10, 10}); ok {
+		return fitted
+	}
+	return y
+}
+
+// fitLine fits the scale to the pixels on a line across the modules of s.
+// line[i] is the pixel at start+i, and dark(k) reports whether the module k is dark.
+// The modules out of s are light, and the pixels are fitted from the two modules before s to the trail modules after s.
+// The origin and the pitch are searched around s with the steps do and dp, which are divided by 5 in each round,
+// to minimize the squared errors from the area averaged modules.
+func fitLine(line []float64, start int, s Scale, dark func(k int) bool, trail int, do, dp float64, rounds []int) (Scale, bool) {
+	i0 := max(int(math.Floor(s.Origin-2*s.Pitch))-start, 0)
+	i1 := min(int(math.Floor(s.Origin+float64(s.Modules+trail)*s.Pitch))-start, len(line))
+	if i1-i0 < s.Modules {
+		return Scale{}, false
+	}
+	lo, hi := line[i0], line[i0]
+	for _, v := range line[i0:i1] {
+		lo = min(lo, v)
+		hi = max(hi, v)
+	}
+	if hi <= lo {
+		return Scale{}, false
+	}
+
+	cost := func(o, p float64) float64 {
+		var sum float64
+		for i := i0; i < i1; i++ {
+			a := float64(start + i)
+			var coverage float64
+			k0 := max(int(math.Floor((a-o)/p)), 0)
+			k1 := min(int(math.Floor((a+1-o)/p)), s.Modules-1)
+			for k := k0; k <= k1; k++ {
+				if dark(k) {
+					b := o + float64(k)*p
+					coverage += max(0, min(a+1, b+p)-max(a, b))
+				}
+			}
+			d := hi - (hi-lo)*coverage - line[i]
+			sum += d * d
+		}
+		return sum
+	}
+
+	o, p := s.Origin, s.Pitch
+	for _, n := range rounds {
+		best := math.Inf(1)
+		bo, bp := o, p
+		for i := -n; i <= n; i++ {
+			for j := -n; j <= n; j++ {
+				if c := cost(o+float64(i)*do, p+float64(j)*dp); c < best {
+					best = c
+					bo, bp = o+float64(i)*do, p+float64(j)*dp
+				}
+			}
+		}
+		o, p = bo, bp
+		do, dp = do/5, dp/5
+	}
+	if p <= 0 {
+		return Scale{}, false
+	}
+	return Scale{Origin: o, Pitch: p, Modules: s.Modules}, true
+}
+
+// Resample resamples the modules of the axis-aligned symbol with the scales x and y.
+// Each pixel is assumed to be the average of the modules it covers, as an image resized by the area averaging,
+// and the modules are solved by the least squares in each axis.
+// So the modules are restored even if they are smaller than two pixels, where no pixel is inside a module.
+func (s *Sampler) Resample(x, y Scale) *bitmap.Image {
+	r := s.Image.Rect
+	rx := newResampler(x, r.Min.X, r.Max.X, s.Threshold)
+	ry := newResampler(y, r.Min.Y, r.Max.Y, s.Threshold)
+
+	// resample the rows, and then the columns.
+	rows := make([][]float64, r.Dy())
+	line := make([]float64, r.Dx())
+	for j := range rows {
+		for i := range line {
+			line[i] = s.Image.At(r.Min.X+i, r.Min.Y+j)
+		}
+		rows[j] = rx.solve(line)
+	}
+	img := bitmap.New(image.Rect(0, 0, x.Modules, y.Modules))
+	column := make([]float64, r.Dy())
+	for i := 0; i < x.Modules; i++ {
+		for j := range column {
+			column[j] = rx.at(rows[j], i)
+		}
+		v := ry.solve(column)
+		for j := 0; j < y.Modules; j++ {
+			img.SetBinary(i, j, bitmap.Color(ry.at(v, j) < s.Threshold))
+		}
+	}
+	return img
+}
+
+// resampler solves the modules from the pixels on a line by the least squares.
+// The modules in the quiet zone are also solved,
+// because the pixels on the edges of the symbol are partially covered by them.
+type resampler struct {
+	// offset is the index of the first module, which is negative in the quiet zone.
+	offset int
+
+	// weights[i] is the coverage of the modules by the pixel i, starting from the module first[i].
+	first   []int
+	weights [][]float64
+
+	// lu is the LU decomposition of the normal matrix in the band storage,
+	// where lu[i][band+j-i] is the element (i, j).
+	lu   [][]float64
+	band int
+
+	// prior is the value that the modules not determined by the pixels approach.
+	prior float64
+}
+
+// ridge is the weight of the prior, which keeps the normal matrix regular
+// even if there are more modules than pixels.
+const ridge = 1e-6
+
+func newResampler(s Scale, start, end int, prior float64) *resampler {
+	r := &resampler{
+		first:   make([]int, end-start),
+		weights: make([][]float64, end-start),
+		prior:   prior,
+	}
+	last := 0
+	for i := range r.weights {
+		p0, p1 := float64(start+i), float64(start+i+1)
+		k0 := int(math.Floor((p0 - s.Origin) / s.Pitch))
+		k1 := int(math.Ceil((p1-s.Origin)/s.Pitch)) - 1
+		r.first[i] = k0
+		for k := k0; k <= k1; k++ {
+			b0 := s.Origin + float64(k)*s.Pitch
+			w := math.Min(p1, b0+s.Pitch) - math.Max(p0, b0)
+			r.weights[i] = append(r.weights[i], w)
+		}
+		if i == 0 {
+			r.offset = k0
+		}
+		last = k1
+		r.band = max(r.band, k1-k0)
+	}
+
+	m := last - r.offset + 1
+	b := r.band
+	lu := make([][]float64, m)
+	for k := range lu {
+		lu[k] = make([]float64, 2*b+1)
+		lu[k][b] = ridge
+	}
+	for i, ws := range r.weights {
+		for a, wa := range ws {
+			for c, wb := range ws {
+				lu[r.first[i]-r.offset+a][b+c-a] += wa * wb
+			}
+		}
+	}
+
+	// the normal matrix is symmetric and positive definite, so no pivoting is needed.
+	for k := 0; k < m; k++ {
+		for i := k + 1; i <= min(k+b, m-1); i++ {
+			f := lu[i][b+k-i] / lu[k][b]
+			lu[i][b+k-i] = f
+			for j := k + 1; j <= min(k+b, m-1); j++ {
+				lu[i][b+j-i] -= f * lu[k][b+j-k]
+			}
+		}
+	}
+	r.lu = lu
+	return r
+}
+
+// solve returns the modules that best fit the pixels, starting from the module offset.
+func (r *resampler) solve(pixels []float64) []float64 {
+	m, b := len(r.lu), r.band
+	v := make([]float64, m)
+	for k := range v {
+		v[k] = ridge * r.prior
+	}
+	for i, ws := range r.weights {
+		for a, w := range ws {
+			v[r.first[i]-r.offset+a] += w * pixels[i]
+		}
+	}
+	for i := 0; i < m; i++ {
+		for k := max(i-b, 0); k < i; k++ {
+			v[i] -= r.lu[i][b+k-i] * v[k]
+		}
+	}
+	for k := m - 1; k >= 0; k-- {
+		for j := k + 1; j <= min(k+b, m-1); j++ {
+			v[k] -= r.lu[k][b+j-k] * v[j]
+		}
+		v[k] /= r.lu[k][b]
+	}
+	return v
+}
+
+// at returns the module k in v solved by r.
+// The modules out of the pixels are the prior.
+func (r *resampler) at(v []float64, k int) float64 {
+	if k < r.offset || k-r.offset >= len(v) {
+		return r.prior
+	}
+	return v[k-r.offset]
+}
@@ -0,0 +1,122 @@
+package sampling
+
+import (
+	"image"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/shogo82148/qrcode/internal/grading"
+)
+
+// areaAverage returns the image of w x h pixels with the modules dark(x, y) on the scales x and y,
+// resized by the area averaging.
+func areaAverage(w, h int, x, y Scale, dark func(x, y int) bool) *grading.Image {
+	img := &grading.Image{
+		Rect:   image.Rect(0, 0, w, h),
+		Pix:    make([]float64, w*h),
+		Stride: w,
+	}
+	overlap := func(s Scale, p, k int) float64 {
+		b0 := s.Origin + float64(k)*s.Pitch
+		return max(0, math.Min(float64(p+1), b0+s.Pitch)-math.Max(float64(p), b0))
+	}
+	for py := 0; py < h; py++ {
+		for px := 0; px < w; px++ {
+			v := 1.0
+			for my := 0; my < y.Modules; my++ {
+				for mx := 0; mx < x.Modules; mx++ {
+					if dark(mx, my) {
+						v -= overlap(x, px, mx) * overlap(y, py, my)
+					}
+				}
+			}
+			img.Pix[py*w+px] = v
+		}
+	}
+	return img
+}
+
+func TestFinderScales(t *testing.T) {
+	x := Scale{Origin: 4.3, Pitch: 1.27, Modules: 7}
+	y := Scale{Origin: 3.8, Pitch: 2.61, Modules: 7}
+	finder := func(x, y int) bool {
+		dx, dy := max(x-3, 3-x), max(y-3, 3-y)
+		return max(dx, dy) != 2
+	}
+	img := areaAverage(20, 30, x, y, finder)
+	rect, run, err := img.Locate()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	gx, gy, ok := New(img).FinderScales(rect, run)
+	if !ok {
+		t.Fatal("finder pattern not found")
+	}
+	for _, tt := range []struct{ got, want Scale }{{gx, x}, {gy, y}} {
+		if math.Abs(tt.got.Origin-tt.want.Origin) > 0.01 || math.Abs(tt.got.Pitch-tt.want.Pitch) > 0.001 {
+			t.Errorf("got %+v, want %+v", tt.got, tt.want)
+		}
+	}
+}
+
+func TestTimingScales(t *testing.T) {
+	const n = 45
+	x := Scale{Origin: 4.3, Pitch: 1.273, Modules: n}
+	y := Scale{Origin: 3.8, Pitch: 1.31, Modules: n}
+	timing := func(k int) bool {
+		return k < 7 || k >= n-7 || (k >= 8 && k <= n-9 && k%2 == 0)
+	}
+	r := rand.New(rand.NewSource(1))
+	modules := make([]bool, n*n)
+	for i := range modules {
+		modules[i] = r.Intn(2) == 0
+	}
+	ideal := func(x, y int) bool {
+		switch {
+		case y == 6:
+			return timing(x)
+		case x == 6:
+			return timing(y)
+		}
+		return modules[y*n+x]
+	}
+	img := areaAverage(int(x.Origin+(n+4)*x.Pitch), int(y.Origin+(n+4)*y.Pitch), x, y, ideal)
+
+	// the scales fitted to the finder pattern, whose pitches are slightly off.
+	rx := Scale{Origin: x.Origin + 0.02, Pitch: x.Pitch * 1.003, Modules: n}
+	ry := Scale{Origin: y.Origin - 0.02, Pitch: y.Pitch * 0.997, Modules: n}
+	s := New(img)
+	gx := s.TimingScaleX(rx, ry, 6, ideal)
+	gy := s.TimingScaleY(rx, ry, 6, ideal)
+	for _, tt := range []struct{ got, want Scale }{{gx, x}, {gy, y}} {
+		if math.Abs(tt.got.Origin-tt.want.Origin) > 0.01 || math.Abs(tt.got.Pitch-tt.want.Pitch) > 0.0002 {
+			t.Errorf("got %+v, want %+v", tt.got, tt.want)
+		}
+	}
+}
+
+func TestResample(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	modules := make([]bool, 21*21)
+	for i := range modules {
+		modules[i] = r.Intn(2) == 0
+	}
+	dark := func(x, y int) bool { return modules[y*21+x] }
+
+	for _, pitch := range []float64{1, 1.05, 1.5, 2.3, 4} {
+		x := Scale{Origin: 2.25 * pitch, Pitch: pitch, Modules: 21}
+		y := Scale{Origin: 2.5 * pitch, Pitch: pitch, Modules: 21}
+		w := int(math.Ceil(25 * pitch))
+		s := New(areaAverage(w, w, x, y, dark))
+		got := s.Resample(x, y)
+		for my := 0; my < 21; my++ {
+			for mx := 0; mx < 21; mx++ {
+				if bool(got.BinaryAt(mx, my)) != dark(mx, my) {
+					t.Errorf("pitch %v: the module (%d, %d) is wrong", pitch, mx, my)
+				}
+			}
+		}
+	}
+}
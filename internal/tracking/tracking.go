@@ -0,0 +1,170 @@
+// Package tracking tracks the symbols in the successive frames of a video,
+// so that each symbol is decoded near where it was found in the previous frame,
+// and reported only once while it stays in view.
+package tracking
+
+import (
+	"image"
+
+	"github.com/shogo82148/qrcode/internal/grading"
+)
+
+// LostFrames is the number of the successive frames in which a symbol is not found
+// before it is considered to have left the view.
+// A symbol is often missed in a frame or two by the motion blur or the glare.
+const LostFrames = 3
+
+// ScanInterval is the maximum number of the frames between the searches of the whole frame.
+// The whole frame is searched at this interval even if all the symbols in view are found around them,
+// so that a new symbol is found while the others are in view.
+const ScanInterval = 4
+
+// Tracker tracks the symbols decoded by Decode.
+type Tracker[T any] struct {
+	// Decode decodes the symbol in img, and returns it with its bounds in img and its payload.
+	// The bounds are fitted to the symbol by the decoder, so they exclude the other things in img.
+	// The symbols with the same payload are the same symbol.
+	// img is a part of the frame, and the decoder may modify it.
+	Decode func(img *grading.Image) (T, image.Rectangle, string, error)
+
+	tracks []track
+
+	// frames is the number of the frames since the last search of the whole frame.
+	frames int
+}
+
+// track is a symbol in view.
+type track struct {
+	// rect is the bounds of the symbol in the last frame where it was found.
+	rect image.Rectangle
+
+	payload string
+
+	// found reports whether the symbol is found in the current frame.
+	found bool
+
+	// missed is the number of the successive frames in which the symbol is not found.
+	missed int
+}
+
+// window returns the area where the symbol is searched in the next frame,
+// which is rect extended by half of its size on each side, within bounds.
+func (tr *track) window(bounds image.Rectangle) image.Rectangle {
+	d := max(tr.rect.Dx(), tr.rect.Dy())/2 + 1
+	return tr.rect.Inset(-d).Intersect(bounds)
+}
+
+// near returns the area where the symbol is searched again if it is not found in the window,
+// which is rect extended by an eighth of its size on each side, within bounds.
+// It excludes the symbols next to the symbol, which are in the window.
+func (tr *track) near(bounds image.Rectangle) image.Rectangle {
+	d := max(tr.rect.Dx(), tr.rect.Dy())/8 + 1
+	return tr.rect.Inset(-d).Intersect(bounds)
+}
+
+// Next decodes the symbols in frame, and returns the symbols that have come into view.
+// Each symbol in view is searched around the bounds in the previous frame,
+// and then just around them if another symbol is next to it.
+// The whole frame is searched only if some of them are not found there, nothing is in view,
+// or ScanInterval frames have passed since the last search of the whole frame.
+// Then the symbols already found are masked out, so that another symbol can be found.
+func (t *Tracker[T]) Next(frame image.Image) []T {
+	bounds := frame.Bounds()
+	var ret []T
+
+	lost := len(t.tracks) == 0
+	for i := range t.tracks {
+		tr := &t.tracks[i]
+		tr.found = false
+		v, rect, payload, ok := t.decode(grading.NewImage(window{frame, tr.window(bounds)}))
+		if !ok {
+			v, rect, payload, ok = t.decode(grading.NewImage(window{frame, tr.near(bounds)}))
+		}
+		if !ok {
+			lost = true
+			continue
+		}
+		if payload != tr.payload {
+			// another symbol has taken the place.
+			ret = append(ret, v)
+			tr.payload = payload
+		}
+		tr.rect = rect
+		tr.found = true
+	}
+
+	t.frames++
+	if lost || t.frames >= ScanInterval {
+		t.frames = 0
+		img := grading.NewImage(frame)
+		background := img.At(bounds.Min.X, bounds.Min.Y)
+		for _, tr := range t.tracks {
+			if tr.found {
+				img.Fill(tr.rect.Inset(-1), background)
+			}
+		}
+		if v, rect, payload, ok := t.decode(img); ok {
+			if i := t.lookup(payload); i >= 0 {
+				// the symbol has moved out of the window.
+				if !t.tracks[i].found {
+					t.tracks[i].rect = rect
+					t.tracks[i].found = true
+				}
+			} else {
+				ret = append(ret, v)
+				t.tracks = append(t.tracks, track{rect: rect, payload: payload, found: true})
+			}
+		}
+	}
+
+	// forget the symbols that have left the view.
+	tracks := t.tracks[:0]
+	for _, tr := range t.tracks {
+		if tr.found {
+			tr.missed = 0
+		} else {
+			tr.missed++
+		}
+		if tr.missed < LostFrames {
+			tracks = append(tracks, tr)
+		}
+	}
+	t.tracks = tracks
+	return ret
+}
+
+// Reset forgets all the symbols in view.
+func (t *Tracker[T]) Reset() {
+	t.tracks = nil
+	t.frames = 0
+}
+
+// decode decodes the symbol in img.
+func (t *Tracker[T]) decode(img *grading.Image) (T, image.Rectangle, string, bool) {
+	v, rect, payload, err := t.Decode(img)
+	if err != nil {
+		var zero T
+		return zero, image.Rectangle{}, "", false
+	}
+	return v, rect, payload, true
+}
+
+// lookup returns the index of the symbol of payload in view, or -1 if there is no such symbol.
+func (t *Tracker[T]) lookup(payload string) int {
+	for i, tr := range t.tracks {
+		if tr.payload == payload {
+			return i
+		}
+	}
+	return -1
+}
+
+// window is the part of an image in rect.
+type window struct {
+	image.Image
+	rect image.Rectangle
+}
+
+func (w window) Bounds() image.Rectangle {
+	return w.rect
+}
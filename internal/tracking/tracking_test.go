@@ -0,0 +1,148 @@
+package tracking
+
+import (
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"testing"
+
+	"github.com/shogo82148/qrcode/internal/grading"
+)
+
+// frame returns a light frame with the dark rectangles.
+// The squares of 10 x 10 pixels are the symbols, and the reflectance of each square is its payload.
+func frame(rects map[image.Rectangle]uint8) *image.Gray {
+	img := image.NewGray(image.Rect(0, 0, 200, 100))
+	for i := range img.Pix {
+		img.Pix[i] = 0xff
+	}
+	for r, v := range rects {
+		for y := r.Min.Y; y < r.Max.Y; y++ {
+			for x := r.Min.X; x < r.Max.X; x++ {
+				img.SetGray(x, y, color.Gray{Y: v})
+			}
+		}
+	}
+	return img
+}
+
+// fakeTracker returns the tracker of the dark squares,
+// and the bounds of the images that it decodes.
+func fakeTracker() (*Tracker[string], *[]image.Rectangle) {
+	var calls []image.Rectangle
+	t := &Tracker[string]{
+		Decode: func(img *grading.Image) (string, image.Rectangle, string, error) {
+			calls = append(calls, img.Rect)
+			rect, _, err := img.Locate()
+			if err != nil {
+				return "", image.Rectangle{}, "", err
+			}
+			if rect.Dx() != 10 || rect.Dy() != 10 {
+				return "", image.Rectangle{}, "", errors.New("not a square")
+			}
+			payload := fmt.Sprint(int(img.At(rect.Min.X, rect.Min.Y)*255 + 0.5))
+			return payload, rect, payload, nil
+		},
+	}
+	return t, &calls
+}
+
+func TestTracker(t *testing.T) {
+	tracker, calls := fakeTracker()
+	full := image.Rect(0, 0, 200, 100)
+
+	a := func(x, y int) image.Rectangle { return image.Rect(x, y, x+10, y+10) }
+	bar := image.Rect(150, 10, 180, 14)
+	tests := []struct {
+		squares map[image.Rectangle]uint8
+		want    []string
+
+		// whether the whole frame is searched.
+		full bool
+	}{
+		// the square comes into view.
+		{map[image.Rectangle]uint8{a(20, 20): 10}, []string{"10"}, true},
+
+		// it moves, and it is found around the last bounds.
+		{map[image.Rectangle]uint8{a(24, 22): 10}, nil, false},
+		{map[image.Rectangle]uint8{a(28, 24): 10}, nil, false},
+
+		// it jumps out of the window, and it is found in the whole frame.
+		{map[image.Rectangle]uint8{a(100, 60): 10}, nil, true},
+
+		// another square comes into view, and it is found in the next search of the whole frame.
+		{map[image.Rectangle]uint8{a(104, 60): 10, a(20, 20): 20}, nil, false},
+		{map[image.Rectangle]uint8{a(108, 60): 10, a(20, 20): 20}, nil, false},
+		{map[image.Rectangle]uint8{a(112, 60): 10, a(20, 20): 20}, nil, false},
+		{map[image.Rectangle]uint8{a(116, 60): 10, a(20, 20): 20}, []string{"20"}, true},
+		{map[image.Rectangle]uint8{a(120, 60): 10, a(24, 20): 20}, nil, false},
+
+		// the first square leaves the view, and a bar that is not a symbol comes in.
+		{map[image.Rectangle]uint8{a(24, 20): 20, bar: 0}, nil, true},
+		{map[image.Rectangle]uint8{a(24, 20): 20, bar: 0}, nil, true},
+		{map[image.Rectangle]uint8{a(24, 20): 20, bar: 0}, nil, true},
+		{map[image.Rectangle]uint8{a(24, 20): 20, bar: 0}, nil, false},
+
+		// and it comes back in the place of the second square.
+		{map[image.Rectangle]uint8{a(24, 20): 10}, []string{"10"}, false},
+	}
+	for i, tt := range tests {
+		*calls = nil
+		got := tracker.Next(frame(tt.squares))
+		if fmt.Sprint(got) != fmt.Sprint(tt.want) {
+			t.Errorf("frame %d: got %v, want %v", i, got, tt.want)
+		}
+		searched := false
+		for _, r := range *calls {
+			if r == full {
+				searched = true
+			}
+		}
+		if searched != tt.full {
+			t.Errorf("frame %d: got whole frame search %t, want %t", i, searched, tt.full)
+		}
+	}
+}
+
+func TestTracker_Adjacent(t *testing.T) {
+	tracker, _ := fakeTracker()
+	a := func(x, y int) image.Rectangle { return image.Rect(x, y, x+10, y+10) }
+	if got := tracker.Next(frame(map[image.Rectangle]uint8{a(20, 20): 10})); fmt.Sprint(got) != "[10]" {
+		t.Fatalf("got %v, want [10]", got)
+	}
+
+	// another square comes into view next to the first one, in its window, and they move together.
+	// the first one is found just around it, and the second one is found in the next search of the whole frame.
+	var got []string
+	for i := 0; i < 2*ScanInterval; i++ {
+		x := 20 + 2*i
+		got = append(got, tracker.Next(frame(map[image.Rectangle]uint8{a(x, 20): 10, a(x+14, 20): 20}))...)
+		for _, tr := range tracker.tracks {
+			if !tr.found {
+				t.Errorf("frame %d: %s is not found", i, tr.payload)
+			}
+			if want := a(x, 20); tr.payload == "10" && tr.rect != want {
+				t.Errorf("frame %d: got %v, want %v", i, tr.rect, want)
+			}
+		}
+	}
+	if fmt.Sprint(got) != "[20]" {
+		t.Errorf("got %v, want [20]", got)
+	}
+}
+
+func TestTracker_Reset(t *testing.T) {
+	tracker, _ := fakeTracker()
+	img := frame(map[image.Rectangle]uint8{image.Rect(20, 20, 30, 30): 10})
+	if got := tracker.Next(img); len(got) != 1 {
+		t.Fatalf("got %v, want a square", got)
+	}
+	if got := tracker.Next(img); len(got) != 0 {
+		t.Fatalf("got %v, want nothing", got)
+	}
+	tracker.Reset()
+	if got := tracker.Next(img); len(got) != 1 {
+		t.Errorf("got %v, want a square", got)
+	}
+}
@@ -0,0 +1,136 @@
+package vector
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"math"
+	"strconv"
+
+	"github.com/shogo82148/go-imaging/bitmap"
+)
+
+// Layer names of DXF drawings.
+const (
+	LayerOutline = "QRCODE"
+	LayerHatch   = "HATCH"
+)
+
+// EncodeDXF writes the outlines of img as a DXF drawing to w.
+// The drawing is in the AutoCAD R12 format, and the coordinates are in millimeters.
+// R12 has no header variable for the drawing units,
+// so the units should be set to millimeters when the drawing is imported.
+// moduleSize is the size of a module in millimeters,
+// and the bottom-left corner of the quiet zone is at the origin.
+//
+// The outlines are closed polylines on the LayerOutline layer.
+// If hatch is positive, the dark modules are filled with horizontal lines
+// at the spacing of hatch millimeters on the LayerHatch layer.
+func EncodeDXF(w io.Writer, img *bitmap.Image, quietZone int, moduleSize, hatch float64) error {
+	if moduleSize <= 0 || math.IsNaN(moduleSize) || math.IsInf(moduleSize, 0) {
+		return errors.New("vector: invalid module size")
+	}
+	if math.IsNaN(hatch) || math.IsInf(hatch, 0) {
+		return errors.New("vector: invalid hatch spacing")
+	}
+
+	bounds := img.Bounds()
+	h := bounds.Dy()
+
+	// convert module coordinates into the drawing coordinates, whose y axis points up.
+	px := func(x int) float64 {
+		return float64(x+quietZone) * moduleSize
+	}
+	py := func(y int) float64 {
+		return float64(h-y+quietZone) * moduleSize
+	}
+
+	d := &dxfWriter{w: bufio.NewWriter(w)}
+	d.pair(0, "SECTION")
+	d.pair(2, "HEADER")
+	d.pair(9, "$ACADVER")
+	d.pair(1, "AC1009")
+	d.pair(0, "ENDSEC")
+
+	d.pair(0, "SECTION")
+	d.pair(2, "ENTITIES")
+	for _, loop := range Outline(img) {
+		d.pair(0, "POLYLINE")
+		d.pair(8, LayerOutline)
+		d.pair(66, "1")
+		d.pair(70, "1") // closed
+		d.point(0, 0, 0)
+		for _, p := range loop {
+			d.pair(0, "VERTEX")
+			d.pair(8, LayerOutline)
+			d.point(0, px(p.X), py(p.Y))
+		}
+		d.pair(0, "SEQEND")
+		d.pair(8, LayerOutline)
+	}
+
+	if hatch > 0 {
+		height := float64(h) * moduleSize
+		for v := hatch / 2; v < height; v += hatch {
+			y := int(v / moduleSize)
+			for x := 0; x < bounds.Dx(); {
+				if !img.BinaryAt(x+bounds.Min.X, y+bounds.Min.Y) {
+					x++
+					continue
+				}
+				start := x
+				for x < bounds.Dx() && img.BinaryAt(x+bounds.Min.X, y+bounds.Min.Y) {
+					x++
+				}
+				Y := float64(h+quietZone)*moduleSize - v
+				d.pair(0, "LINE")
+				d.pair(8, LayerHatch)
+				d.point(0, px(start), Y)
+				d.point(1, px(x), Y)
+			}
+		}
+	}
+	d.pair(0, "ENDSEC")
+	d.pair(0, "EOF")
+	return d.flush()
+}
+
+type dxfWriter struct {
+	w   *bufio.Writer
+	err error
+}
+
+// pair writes a group code and its value.
+func (d *dxfWriter) pair(code int, value string) {
+	if d.err != nil {
+		return
+	}
+	d.w.WriteString(strconv.Itoa(code))
+	d.w.WriteByte('\n')
+	d.w.WriteString(value)
+	_, d.err = d.w.WriteString("\n")
+}
+
+// point writes a 2D point with the group codes 10+i and 20+i.
+func (d *dxfWriter) point(i int, x, y float64) {
+	d.pair(10+i, formatFloat(x))
+	d.pair(20+i, formatFloat(y))
+	d.pair(30+i, "0.0")
+}
+
+func (d *dxfWriter) flush() error {
+	if d.err != nil {
+		return d.err
+	}
+	return d.w.Flush()
+}
+
+func formatFloat(v float64) string {
+	s := strconv.FormatFloat(v, 'f', -1, 64)
+	for _, c := range s {
+		if c == '.' {
+			return s
+		}
+	}
+	return s + ".0"
+}
@@ -0,0 +1,84 @@
+package vector
+
+import (
+	"bufio"
+	"bytes"
+	"strings"
+	"testing"
+)
+
+type dxfPair struct {
+	code  string
+	value string
+}
+
+func parseDXF(t *testing.T, data []byte) []dxfPair {
+	t.Helper()
+	var ret []dxfPair
+	s := bufio.NewScanner(bytes.NewReader(data))
+	for s.Scan() {
+		code := strings.TrimSpace(s.Text())
+		if !s.Scan() {
+			t.Fatalf("missing value of the group code %s", code)
+		}
+		ret = append(ret, dxfPair{code, s.Text()})
+	}
+	return ret
+}
+
+func TestEncodeDXF(t *testing.T) {
+	img := parseBitmap(
+		"###",
+		"# #",
+		"###",
+	)
+	var buf bytes.Buffer
+	if err := EncodeDXF(&buf, img, 1, 0.5, 0.25); err != nil {
+		t.Fatal(err)
+	}
+	pairs := parseDXF(t, buf.Bytes())
+
+	var polylines, vertices, lines int
+	for _, p := range pairs {
+		if p.code != "0" {
+			continue
+		}
+		switch p.value {
+		case "POLYLINE":
+			polylines++
+		case "VERTEX":
+			vertices++
+		case "LINE":
+			lines++
+		}
+	}
+	if polylines != 2 {
+		t.Errorf("unexpected number of polylines: got %d, want %d", polylines, 2)
+	}
+	if vertices != 8 {
+		t.Errorf("unexpected number of vertices: got %d, want %d", vertices, 8)
+	}
+	// 6 scan lines, and the middle 2 lines are split by the hole.
+	if lines != 8 {
+		t.Errorf("unexpected number of lines: got %d, want %d", lines, 8)
+	}
+	// the R12 header has only the version.
+	for _, p := range pairs {
+		if p.code == "9" && p.value != "$ACADVER" {
+			t.Errorf("unexpected header variable for R12: %q", p.value)
+		}
+	}
+	if last := pairs[len(pairs)-1]; last.value != "EOF" {
+		t.Errorf("unexpected last value: %q", last.value)
+	}
+
+	// the first vertex is the top-left corner of the symbol.
+	for i, p := range pairs {
+		if p.code == "0" && p.value == "VERTEX" {
+			if x, y := pairs[i+2].value, pairs[i+3].value; x != "0.5" || y != "2.0" {
+				t.Errorf("unexpected vertex: (%s, %s)", x, y)
+			}
+			break
+		}
+	}
+}
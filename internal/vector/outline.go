@@ -0,0 +1,103 @@
+// Package vector converts symbols into vector graphics.
+package vector
+
+import (
+	"image"
+
+	"github.com/shogo82148/go-imaging/bitmap"
+)
+
+// Outline returns the outlines of the regions of dark modules in img.
+// Adjacent dark modules are merged into one region,
+// and the modules that touch only at their corners are in different regions.
+//
+// Each outline is a closed polygon in module coordinates without the duplicated end point.
+// In the coordinate system whose y axis points down,
+// the outer boundaries are clockwise and the boundaries of holes are counterclockwise.
+func Outline(img *bitmap.Image) [][]image.Point {
+	type edge struct {
+		from, to image.Point
+	}
+
+	bounds := img.Bounds()
+	dark := func(x, y int) bool {
+		return bool(img.BinaryAt(x+bounds.Min.X, y+bounds.Min.Y))
+	}
+
+	// collect the boundary edges. the dark modules are on the right side of the edges.
+	outgoing := map[image.Point][]edge{}
+	var edges []edge
+	add := func(x0, y0, x1, y1 int) {
+		e := edge{image.Pt(x0, y0), image.Pt(x1, y1)}
+		outgoing[e.from] = append(outgoing[e.from], e)
+		edges = append(edges, e)
+	}
+	w, h := bounds.Dx(), bounds.Dy()
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			if !dark(x, y) {
+				continue
+			}
+			if !dark(x, y-1) {
+				add(x, y, x+1, y)
+			}
+			if !dark(x+1, y) {
+				add(x+1, y, x+1, y+1)
+			}
+			if !dark(x, y+1) {
+				add(x+1, y+1, x, y+1)
+			}
+			if !dark(x-1, y) {
+				add(x, y+1, x, y)
+			}
+		}
+	}
+
+	// link the edges into loops.
+	used := map[edge]bool{}
+	var ret [][]image.Point
+	for _, start := range edges {
+		if used[start] {
+			continue
+		}
+		var loop []image.Point
+		e := start
+		for !used[e] {
+			used[e] = true
+			loop = append(loop, e.from)
+
+			candidates := outgoing[e.to]
+			next := candidates[0]
+			if len(candidates) > 1 {
+				// two regions touch at their corners.
+				// turn right to keep them separated.
+				d := e.to.Sub(e.from)
+				right := e.to.Add(image.Pt(-d.Y, d.X))
+				for _, c := range candidates {
+					if c.to == right {
+						next = c
+					}
+				}
+			}
+			e = next
+		}
+		ret = append(ret, simplify(loop))
+	}
+	return ret
+}
+
+// simplify removes the vertices on straight lines.
+func simplify(loop []image.Point) []image.Point {
+	n := len(loop)
+	ret := make([]image.Point, 0, n)
+	for i, p := range loop {
+		prev := loop[(i+n-1)%n]
+		next := loop[(i+1)%n]
+		d0, d1 := p.Sub(prev), next.Sub(p)
+		if d0.X*d1.Y-d0.Y*d1.X == 0 {
+			continue
+		}
+		ret = append(ret, p)
+	}
+	return ret
+}
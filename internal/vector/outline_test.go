@@ -0,0 +1,100 @@
+package vector
+
+import (
+	"image"
+	"testing"
+
+	"github.com/shogo82148/go-imaging/bitmap"
+)
+
+func parseBitmap(rows ...string) *bitmap.Image {
+	img := bitmap.New(image.Rect(0, 0, len(rows[0]), len(rows)))
+	for y, row := range rows {
+		for x, c := range row {
+			img.SetBinary(x, y, bitmap.Color(c == '#'))
+		}
+	}
+	return img
+}
+
+// area returns the signed area of the polygon.
+// It is positive if the polygon is clockwise in the coordinate system whose y axis points down.
+func area(loop []image.Point) int {
+	var sum int
+	for i, p := range loop {
+		q := loop[(i+1)%len(loop)]
+		sum += p.X*q.Y - q.X*p.Y
+	}
+	return sum / 2
+}
+
+func TestOutline(t *testing.T) {
+	tests := []struct {
+		rows  []string
+		loops []int // the areas of loops
+	}{
+		{
+			rows:  []string{"#"},
+			loops: []int{1},
+		},
+		{
+			rows: []string{
+				"###",
+				"# #",
+				"###",
+			},
+			loops: []int{9, -1},
+		},
+		{
+			// touch at the corner
+			rows: []string{
+				"#.",
+				".#",
+			},
+			loops: []int{1, 1},
+		},
+		{
+			rows: []string{
+				"##.",
+				"#.#",
+				".##",
+			},
+			loops: []int{3, 3},
+		},
+	}
+
+	for i, tt := range tests {
+		img := parseBitmap(tt.rows...)
+		loops := Outline(img)
+		if len(loops) != len(tt.loops) {
+			t.Errorf("%d: unexpected number of loops: got %d, want %d", i, len(loops), len(tt.loops))
+			continue
+		}
+		for j, loop := range loops {
+			if got := area(loop); got != tt.loops[j] {
+				t.Errorf("%d: unexpected area of loop %d: got %d, want %d", i, j, got, tt.loops[j])
+			}
+		}
+	}
+}
+
+func TestOutline_Simplify(t *testing.T) {
+	img := parseBitmap(
+		"####",
+		"####",
+	)
+	loops := Outline(img)
+	if len(loops) != 1 {
+		t.Fatalf("unexpected number of loops: got %d, want 1", len(loops))
+	}
+	want := []image.Point{{0, 0}, {4, 0}, {4, 2}, {0, 2}}
+	if len(loops[0]) != len(want) {
+		t.Fatalf("got %v, want %v", loops[0], want)
+	}
+	for i := range want {
+		if loops[0][i] != want[i] {
+			t.Errorf("got %v, want %v", loops[0], want)
+			break
+		}
+	}
+}
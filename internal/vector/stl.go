@@ -0,0 +1,325 @@
+package vector
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"image"
+	"io"
+	"math"
+	"slices"
+
+	"github.com/shogo82148/go-imaging/bitmap"
+)
+
+// Vertex is a point in the 3D space.
+type Vertex [3]float64
+
+// Triangle is a facet of solids.
+// The vertices are counterclockwise when seen from the outside.
+type Triangle [3]Vertex
+
+// Normal returns the unit normal vector of t.
+func (t Triangle) Normal() Vertex {
+	u := Vertex{t[1][0] - t[0][0], t[1][1] - t[0][1], t[1][2] - t[0][2]}
+	v := Vertex{t[2][0] - t[0][0], t[2][1] - t[0][1], t[2][2] - t[0][2]}
+	n := Vertex{
+		u[1]*v[2] - u[2]*v[1],
+		u[2]*v[0] - u[0]*v[2],
+		u[0]*v[1] - u[1]*v[0],
+	}
+	l := math.Sqrt(n[0]*n[0] + n[1]*n[1] + n[2]*n[2])
+	if l == 0 {
+		return Vertex{}
+	}
+	return Vertex{n[0] / l, n[1] / l, n[2] / l}
+}
+
+// Extrude builds a solid of img on a base plate.
+// The base plate covers the symbol and the quiet zone, and its thickness is base.
+// The dark modules are raised by height above the base plate.
+// If inverted is true, the light modules and the quiet zone are raised instead,
+// and the dark modules are engraved.
+//
+// The unit of moduleSize, base and height is millimeters.
+// The bottom-left corner of the base plate is at the origin.
+// The coplanar faces are merged into rectangles and the walls into straight runs,
+// and each of them is split into triangles at its corners only,
+// so a vertex of a face may lie on an edge of the neighboring face.
+// The raised modules that touch only at their corners are separated by small chamfers,
+// so that no edge is shared by more than two faces.
+func Extrude(img *bitmap.Image, quietZone int, moduleSize, base, height float64, inverted bool) ([]Triangle, error) {
+	if moduleSize <= 0 || base <= 0 || height <= 0 {
+		return nil, errors.New("vector: invalid size")
+	}
+
+	// raised is the mask of the raised modules including the quiet zone.
+	bounds := img.Bounds()
+	w, h := bounds.Dx()+quietZone*2, bounds.Dy()+quietZone*2
+	raised := bitmap.New(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			dark := img.BinaryAt(x-quietZone+bounds.Min.X, y-quietZone+bounds.Min.Y)
+			raised.SetBinary(x, y, dark != bitmap.Color(inverted))
+		}
+	}
+	pinches := pinchPoints(raised)
+	pinched := map[image.Point]bool{}
+	for _, p := range pinches {
+		pinched[p] = true
+	}
+
+	// convert module coordinates into the model coordinates, whose y axis points up.
+	pt := func(p point, z float64) Vertex {
+		return Vertex{p.x * moduleSize, (float64(h) - p.y) * moduleSize, z}
+	}
+	top := base + height
+
+	var ret []Triangle
+
+	// face adds the convex polygon at z, which faces up if up is true.
+	face := func(polygon []point, z float64, up bool) {
+		// the module coordinates and the view from above have the same orientation,
+		// so the polygon needs to be counterclockwise in module coordinates to face up.
+		if (signedArea(polygon) > 0) == up {
+			polygon = slices.Clone(polygon)
+			slices.Reverse(polygon)
+		}
+		for i := 1; i+1 < len(polygon); i++ {
+			ret = append(ret, Triangle{pt(polygon[0], z), pt(polygon[i], z), pt(polygon[i+1], z)})
+		}
+	}
+
+	// wall adds the vertical faces along the polygon between z0 and z1.
+	// The faces are on the left side of the polygon in the module coordinates.
+	wall := func(polygon []point, z0, z1 float64) {
+		for i, a := range polygon {
+			b := polygon[(i+1)%len(polygon)]
+			ret = append(ret,
+				Triangle{pt(b, z0), pt(a, z0), pt(a, z1)},
+				Triangle{pt(b, z0), pt(a, z1), pt(b, z1)},
+			)
+		}
+	}
+
+	// the top faces, whose corners at the pinch points are chamfered.
+	for _, r := range rectangles(raised, true) {
+		face(chamfered(corners(r), pinched), top, true)
+	}
+	for _, r := range rectangles(raised, false) {
+		face(corners(r), base, true)
+	}
+
+	// the chamfers are engraved to the base plate.
+	for _, p := range pinches {
+		for _, d := range []image.Point{{1, 1}, {-1, -1}, {1, -1}, {-1, 1}} {
+			// the module on the d side of p.
+			x, y := p.X+min(d.X, 0), p.Y+min(d.Y, 0)
+			if !raised.BinaryAt(x, y) {
+				continue
+			}
+			c := toPoint(p)
+			face([]point{c, {c.x + float64(d.X)*chamfer, c.y}, {c.x, c.y + float64(d.Y)*chamfer}}, base, true)
+		}
+	}
+
+	// the walls of the raised modules.
+	// the raised modules are on the right side of the outlines,
+	// so the walls face to the left side.
+	for _, loop := range Outline(raised) {
+		polygon := make([]point, len(loop))
+		for i, p := range loop {
+			polygon[i] = toPoint(p)
+		}
+		wall(chamfered(polygon, pinched), base, top)
+	}
+
+	// the base plate
+	plate := corners(image.Rect(0, 0, w, h))
+	wall(plate, 0, base)
+	face(plate, 0, false)
+	return ret, nil
+}
+
+// chamfer is the size of the chamfers at the pinch points in modules.
+const chamfer = 1.0 / 64
+
+// point is a point in module coordinates.
+type point struct {
+	x, y float64
+}
+
+func toPoint(p image.Point) point {
+	return point{float64(p.X), float64(p.Y)}
+}
+
+// corners returns the corners of r, which are clockwise in module coordinates.
+func corners(r image.Rectangle) []point {
+	return []point{
+		toPoint(r.Min),
+		toPoint(image.Pt(r.Max.X, r.Min.Y)),
+		toPoint(r.Max),
+		toPoint(image.Pt(r.Min.X, r.Max.Y)),
+	}
+}
+
+// signedArea returns the area of the polygon, which is positive if it is clockwise in module coordinates.
+func signedArea(polygon []point) float64 {
+	var sum float64
+	for i, a := range polygon {
+		b := polygon[(i+1)%len(polygon)]
+		sum += a.x*b.y - a.y*b.x
+	}
+	return sum / 2
+}
+
+// chamfered replaces the vertices of the polygon at the pinch points
+// with the chamfers along the adjacent edges.
+func chamfered(polygon []point, pinched map[image.Point]bool) []point {
+	n := len(polygon)
+	ret := make([]point, 0, n)
+	for i, p := range polygon {
+		if p.x != math.Trunc(p.x) || p.y != math.Trunc(p.y) || !pinched[image.Pt(int(p.x), int(p.y))] {
+			ret = append(ret, p)
+			continue
+		}
+		prev, next := polygon[(i+n-1)%n], polygon[(i+1)%n]
+		ret = append(ret, toward(p, prev), toward(p, next))
+	}
+	return ret
+}
+
+// toward returns the point on the axis-aligned segment from p to q that is the chamfer size away from p.
+func toward(p, q point) point {
+	return point{
+		p.x + chamfer*float64(sign(q.x-p.x)),
+		p.y + chamfer*float64(sign(q.y-p.y)),
+	}
+}
+
+// pinchPoints returns the module corners where two raised modules touch only at their corners.
+func pinchPoints(raised *bitmap.Image) []image.Point {
+	bounds := raised.Bounds()
+	var ret []image.Point
+	for y := bounds.Min.Y + 1; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X + 1; x < bounds.Max.X; x++ {
+			a, b := raised.BinaryAt(x-1, y-1), raised.BinaryAt(x, y-1)
+			c, d := raised.BinaryAt(x-1, y), raised.BinaryAt(x, y)
+			if a == d && b == c && a != b {
+				ret = append(ret, image.Pt(x-bounds.Min.X, y-bounds.Min.Y))
+			}
+		}
+	}
+	return ret
+}
+
+func sign[T int | float64](x T) int {
+	switch {
+	case x > 0:
+		return 1
+	case x < 0:
+		return -1
+	}
+	return 0
+}
+
+// rectangles covers the pixels of the color c in img with rectangles.
+// The rectangles are grown greedily, first to the right and then to the bottom.
+func rectangles(img *bitmap.Image, c bitmap.Color) []image.Rectangle {
+	bounds := img.Bounds()
+	w, h := bounds.Dx(), bounds.Dy()
+	used := make([]bool, w*h)
+	match := func(x, y int) bool {
+		return !used[y*w+x] && img.BinaryAt(x+bounds.Min.X, y+bounds.Min.Y) == c
+	}
+
+	var ret []image.Rectangle
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			if !match(x, y) {
+				continue
+			}
+			x1 := x + 1
+			for x1 < w && match(x1, y) {
+				x1++
+			}
+			y1 := y + 1
+		GROW:
+			for y1 < h {
+				for i := x; i < x1; i++ {
+					if !match(i, y1) {
+						break GROW
+					}
+				}
+				y1++
+			}
+			for j := y; j < y1; j++ {
+				for i := x; i < x1; i++ {
+					used[j*w+i] = true
+				}
+			}
+			ret = append(ret, image.Rect(x, y, x1, y1))
+		}
+	}
+	return ret
+}
+
+// EncodeSTL writes the triangles as an STL file to w.
+// If ascii is true, the ASCII format is used, otherwise the binary format is used.
+// name is the name of the solid.
+func EncodeSTL(w io.Writer, triangles []Triangle, name string, ascii bool) error {
+	if ascii {
+		return encodeASCIISTL(w, triangles, name)
+	}
+	return encodeBinarySTL(w, triangles, name)
+}
+
+func encodeBinarySTL(w io.Writer, triangles []Triangle, name string) error {
+	if uint64(len(triangles)) > math.MaxUint32 {
+		return errors.New("vector: too many triangles")
+	}
+	bw := bufio.NewWriter(w)
+
+	var header [80]byte
+	copy(header[:], name)
+	bw.Write(header[:])
+
+	var buf [50]byte
+	binary.LittleEndian.PutUint32(buf[:4], uint32(len(triangles)))
+	bw.Write(buf[:4])
+	for _, t := range triangles {
+		n := t.Normal()
+		vs := [4]Vertex{n, t[0], t[1], t[2]}
+		for i, v := range vs {
+			for j, f := range v {
+				binary.LittleEndian.PutUint32(buf[i*12+j*4:], math.Float32bits(float32(f)))
+			}
+		}
+		// attribute byte count
+		buf[48], buf[49] = 0, 0
+		if _, err := bw.Write(buf[:]); err != nil {
+			return err
+		}
+	}
+	return bw.Flush()
+}
+
+func encodeASCIISTL(w io.Writer, triangles []Triangle, name string) error {
+	bw := bufio.NewWriter(w)
+	fmt.Fprintf(bw, "solid %s\n", name)
+	for _, t := range triangles {
+		n := t.Normal()
+		fmt.Fprintf(bw, "  facet normal %g %g %g\n", n[0], n[1], n[2])
+		bw.WriteString("    outer loop\n")
+		for _, v := range t {
+			fmt.Fprintf(bw, "      vertex %g %g %g\n", v[0], v[1], v[2])
+		}
+		bw.WriteString("    endloop\n")
+		if _, err := bw.WriteString("  endfacet\n"); err != nil {
+			return err
+		}
+	}
+	fmt.Fprintf(bw, "endsolid %s\n", name)
+	return bw.Flush()
+}
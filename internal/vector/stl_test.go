@@ -0,0 +1,184 @@
+package vector
+
+import (
+	"bytes"
+	"cmp"
+	"encoding/binary"
+	"math"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/shogo82148/go-imaging/bitmap"
+)
+
+// volume returns the volume of the closed solid.
+func volume(triangles []Triangle) float64 {
+	var sum float64
+	for _, t := range triangles {
+		a, b, c := t[0], t[1], t[2]
+		sum += a[0]*(b[1]*c[2]-b[2]*c[1]) - a[1]*(b[0]*c[2]-b[2]*c[0]) + a[2]*(b[0]*c[1]-b[1]*c[0])
+	}
+	return sum / 6
+}
+
+func TestExtrude(t *testing.T) {
+	img := parseBitmap(
+		"##.",
+		"#.#",
+		".##",
+	)
+	tests := []struct {
+		inverted bool
+		raised   int
+	}{
+		{false, 6},
+		{true, 3 + 16}, // 3 light modules and the quiet zone of 1 module
+	}
+	for _, tt := range tests {
+		triangles, err := Extrude(img, 1, 2, 1.5, 0.5, tt.inverted)
+		if err != nil {
+			t.Fatal(err)
+		}
+		// two raised modules touch at two corners, and each of them is chamfered.
+		c := chamfer * 2
+		want := 10*10*1.5 + float64(tt.raised)*2*2*0.5 - 2*2*(c*c/2)*0.5
+		if got := volume(triangles); math.Abs(got-want) > 1e-9 {
+			t.Errorf("inverted = %v: unexpected volume: got %f, want %f", tt.inverted, got, want)
+		}
+	}
+}
+
+func TestExtrude_Manifold(t *testing.T) {
+	images := []*bitmap.Image{
+		parseBitmap(
+			"###.",
+			"#...",
+			"##.#",
+		),
+		// the modules touch only at their corners.
+		parseBitmap(
+			"#.#",
+			".#.",
+			"#.#",
+		),
+	}
+	for k, img := range images {
+		for _, quietZone := range []int{0, 1} {
+			for _, inverted := range []bool{false, true} {
+				triangles, err := Extrude(img, quietZone, 2, 1.5, 0.5, inverted)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var vertices []Vertex
+				for _, tri := range triangles {
+					if tri.Normal() == (Vertex{}) {
+						t.Errorf("%d, quietZone = %d, inverted = %v: degenerate triangle %v", k, quietZone, inverted, tri)
+					}
+					vertices = append(vertices, tri[:]...)
+				}
+
+				// each edge is shared by exactly two triangles in the opposite directions,
+				// after the edges are split at the vertices on them.
+				edges := map[[2]Vertex]int{}
+				for _, tri := range triangles {
+					for i := range tri {
+						for _, e := range splitEdge(tri[i], tri[(i+1)%3], vertices) {
+							edges[e]++
+						}
+					}
+				}
+				for e, n := range edges {
+					if n != 1 || edges[[2]Vertex{e[1], e[0]}] != 1 {
+						t.Errorf("%d, quietZone = %d, inverted = %v: the edge %v is not manifold", k, quietZone, inverted, e)
+					}
+				}
+			}
+		}
+	}
+}
+
+// splitEdge splits the edge from a to b at the vertices on it.
+func splitEdge(a, b Vertex, vertices []Vertex) [][2]Vertex {
+	d := Vertex{b[0] - a[0], b[1] - a[1], b[2] - a[2]}
+	l := d[0]*d[0] + d[1]*d[1] + d[2]*d[2]
+	type cut struct {
+		t float64
+		v Vertex
+	}
+	cuts := []cut{{0, a}, {1, b}}
+	seen := map[Vertex]bool{a: true, b: true}
+	for _, v := range vertices {
+		if seen[v] {
+			continue
+		}
+		seen[v] = true
+		e := Vertex{v[0] - a[0], v[1] - a[1], v[2] - a[2]}
+		t := (e[0]*d[0] + e[1]*d[1] + e[2]*d[2]) / l
+		if t <= 0 || t >= 1 {
+			continue
+		}
+		f := Vertex{e[0] - t*d[0], e[1] - t*d[1], e[2] - t*d[2]}
+		if f[0]*f[0]+f[1]*f[1]+f[2]*f[2] > 1e-18 {
+			continue
+		}
+		cuts = append(cuts, cut{t, v})
+	}
+	slices.SortFunc(cuts, func(x, y cut) int { return cmp.Compare(x.t, y.t) })
+	ret := make([][2]Vertex, len(cuts)-1)
+	for i := range ret {
+		ret[i] = [2]Vertex{cuts[i].v, cuts[i+1].v}
+	}
+	return ret
+}
+
+func TestExtrude_Invalid(t *testing.T) {
+	img := parseBitmap("#")
+	if _, err := Extrude(img, 1, 1, 0, 1, false); err == nil {
+		t.Error("want error for the base plate of zero thickness, got nil")
+	}
+}
+
+func TestRectangles(t *testing.T) {
+	img := parseBitmap(
+		"####",
+		"####",
+		"##..",
+	)
+	got := rectangles(img, true)
+	if len(got) != 2 {
+		t.Errorf("unexpected number of rectangles: got %d, want %d", len(got), 2)
+	}
+}
+
+func TestEncodeSTL(t *testing.T) {
+	img := parseBitmap("#")
+	triangles, err := Extrude(img, 0, 1, 1, 1, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	if err := EncodeSTL(&buf, triangles, "test", false); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := buf.Len(), 84+50*len(triangles); got != want {
+		t.Errorf("unexpected size: got %d, want %d", got, want)
+	}
+	if got := binary.LittleEndian.Uint32(buf.Bytes()[80:]); got != uint32(len(triangles)) {
+		t.Errorf("unexpected number of triangles: got %d, want %d", got, len(triangles))
+	}
+
+	buf.Reset()
+	if err := EncodeSTL(&buf, triangles, "test", true); err != nil {
+		t.Fatal(err)
+	}
+	s := buf.String()
+	if !strings.HasPrefix(s, "solid test\n") || !strings.HasSuffix(s, "endsolid test\n") {
+		t.Errorf("unexpected ASCII STL: %q", s)
+	}
+	if got := strings.Count(s, "facet normal"); got != len(triangles) {
+		t.Errorf("unexpected number of facets: got %d, want %d", got, len(triangles))
+	}
+}
@@ -0,0 +1,183 @@
+package qrcode
+
+import (
+	"errors"
+	"fmt"
+	"image"
+	"image/draw"
+	"math"
+
+	"github.com/shogo82148/go-imaging/bitmap"
+	"github.com/shogo82148/go-imaging/fp16"
+	"github.com/shogo82148/go-imaging/resize"
+	"github.com/shogo82148/go-imaging/srgb"
+)
+
+// WithLogo embeds logo into the center of the symbol.
+// size is the ratio of the width of the logo area to the width of the symbol,
+// excluding the quiet zone.
+//
+// The modules under the logo area are cleared unless [WithLogoOverlay] is enabled.
+// The covered codewords are recovered by the error correction.
+// They are limited to half of the correction capacity of each block,
+// so that the symbol still tolerates the errors in printing and scanning.
+// [New] raises the level or the version until the logo fits the limit.
+// [QRCode.Encode] returns an error if the symbol would not decode reliably.
+func WithLogo(logo image.Image, size float64) EncodeOptions {
+	return func(opts *encodeOptions) {
+		opts.Logo = logo
+		opts.LogoSize = size
+	}
+}
+
+// WithLogoOverlay sets whether the logo is overlaid on the modules.
+// The default is false, and the modules under the logo area are cleared.
+func WithLogoOverlay(overlay bool) EncodeOptions {
+	return func(opts *encodeOptions) {
+		opts.LogoOverlay = overlay
+	}
+}
+
+// levelOrder is the list of the levels from the weakest to the strongest.
+var levelOrder = [...]Level{LevelL, LevelM, LevelQ, LevelH}
+
+// fitLogo raises the version and the level of qr
+// until the logo area can be recovered by the error correction.
+func (qr *QRCode) fitLogo(size float64) error {
+	var start int
+	for i, lv := range levelOrder {
+		if lv == qr.Level {
+			start = i
+		}
+	}
+
+	for version := qr.Version; version <= 40; version++ {
+		area := logoArea(version, size)
+		for _, level := range levelOrder[start:] {
+			if !fitsIn(version, level, qr.Segments) {
+				continue
+			}
+			if err := checkLogo(version, level, area); err == nil {
+				qr.Version = version
+				qr.Level = level
+				return nil
+			}
+		}
+	}
+	return errors.New("qrcode: logo is too large")
+}
+
+// logoArea returns the area covered by the logo in module coordinates.
+// The area is aligned to the modules and centered in the symbol.
+func logoArea(version Version, size float64) image.Rectangle {
+	n := 17 + 4*int(version)
+	k := int(math.Ceil(size * float64(n)))
+	if k <= 0 {
+		return image.Rectangle{}
+	}
+	if k%2 != n%2 {
+		k++
+	}
+	k = min(k, n)
+	offset := (n - k) / 2
+	return image.Rect(offset, offset, offset+k, offset+k)
+}
+
+// checkLogo checks whether the codewords covered by area can be recovered by the error correction
+// with half of the correction capacity left for the other errors.
+func checkLogo(version Version, level Level, area image.Rectangle) error {
+	if area.Empty() {
+		return nil
+	}
+
+	// finder patterns, format information and version information can't be recovered.
+	n := 17 + 4*int(version)
+	critical := []image.Rectangle{
+		image.Rect(0, 0, 9, 9),
+		image.Rect(n-8, 0, n, 9),
+		image.Rect(0, n-8, 9, n),
+	}
+	if version >= 7 {
+		critical = append(critical, image.Rect(0, n-11, 6, n-8), image.Rect(n-11, 0, n-8, 6))
+	}
+	for _, r := range critical {
+		if r.Overlaps(area) {
+			return errors.New("qrcode: logo covers function patterns")
+		}
+	}
+
+	// count the number of covered codewords in each block.
+	capacity := capacityTable[version][level]
+	var maxErrors []int
+	for _, blockCapacity := range capacity.Blocks {
+		for i := 0; i < blockCapacity.Num; i++ {
+			maxErrors = append(maxErrors, blockCapacity.MaxError)
+		}
+	}
+	codewords := deinterleave(version, level)
+	covered := make([]bool, len(codewords))
+	errs := make([]int, len(maxErrors))
+	for i, pt := range placement(version) {
+		idx := i / 8
+		if idx >= len(codewords) {
+			// remainder bits
+			break
+		}
+		if !pt.In(area) || covered[idx] {
+			continue
+		}
+		covered[idx] = true
+		errs[codewords[idx].block]++
+	}
+
+	for i, n := range errs {
+		if limit := maxErrors[i] / 2; n > limit {
+			return fmt.Errorf("qrcode: logo covers %d codewords in block %d, but at most %d codewords can be covered", n, i, limit)
+		}
+	}
+	return nil
+}
+
+// clearLogoArea clears the modules in area.
+// The function patterns, such as the alignment patterns, are kept.
+func clearLogoArea(img *bitmap.Image, version Version, area image.Rectangle) {
+	used := usedList[version]
+	for y := area.Min.Y; y < area.Max.Y; y++ {
+		for x := area.Min.X; x < area.Max.X; x++ {
+			if used.BinaryAt(x, y) {
+				continue
+			}
+			img.SetBinary(x, y, bitmap.White)
+		}
+	}
+}
+
+// drawLogo draws logo into area of dst.
+// area is in module coordinates, and scale is the number of pixels per module.
+func drawLogo(dst draw.Image, logo image.Image, area image.Rectangle, quietZone int, scale float64) {
+	r := image.Rect(
+		round(float64(area.Min.X+quietZone)*scale),
+		round(float64(area.Min.Y+quietZone)*scale),
+		round(float64(area.Max.X+quietZone)*scale),
+		round(float64(area.Max.Y+quietZone)*scale),
+	)
+
+	// keep the aspect ratio of the logo.
+	bounds := logo.Bounds()
+	if bounds.Empty() || r.Empty() {
+		return
+	}
+	w, h := r.Dx(), r.Dy()
+	if bounds.Dx()*h > bounds.Dy()*w {
+		h = max(1, w*bounds.Dy()/bounds.Dx())
+	} else {
+		w = max(1, h*bounds.Dx()/bounds.Dy())
+	}
+	r.Min.X += (r.Dx() - w) / 2
+	r.Min.Y += (r.Dy() - h) / 2
+	r.Max = r.Min.Add(image.Pt(w, h))
+
+	resized := fp16.NewNRGBAh(image.Rect(0, 0, w, h))
+	resize.AreaAverage(resized, srgb.DecodeTone(logo))
+	draw.Draw(dst, r, srgb.EncodeTone(resized), image.Point{}, draw.Over)
+}
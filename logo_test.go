@@ -0,0 +1,167 @@
+package qrcode
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/draw"
+	"testing"
+
+	"github.com/shogo82148/qrcode/internal/bitstream"
+)
+
+func TestPlacement(t *testing.T) {
+	for version := Version(1); version <= 40; version++ {
+		used := usedList[version]
+		want := used.Rect.Dx()*used.Rect.Dy() - used.OnesCount()
+		if got := len(placement(version)); got != want {
+			t.Errorf("version %d: unexpected number of modules: got %d, want %d", version, got, want)
+		}
+	}
+}
+
+func TestDeinterleave(t *testing.T) {
+	for version := Version(1); version <= 40; version++ {
+		for _, level := range levelOrder {
+			qr := &QRCode{
+				Version: version,
+				Level:   level,
+				Segments: []Segment{
+					{Mode: ModeBytes, Data: []byte("hello")},
+				},
+			}
+			var buf bitstream.Buffer
+			if err := qr.encodeToBits(&buf); err != nil {
+				t.Fatal(err)
+			}
+			stream := buf.Bytes()
+			blocks := decodeFromBits(version, level, stream)
+			for i, pos := range deinterleave(version, level) {
+				blk := blocks[pos.block]
+				var got byte
+				if pos.correction {
+					got = blk.correction[pos.index-len(blk.data)]
+				} else {
+					got = blk.data[pos.index]
+				}
+				if got != stream[i] {
+					t.Fatalf("version %d, level %s: codeword %d mismatch", version, level, i)
+				}
+			}
+		}
+	}
+}
+
+func TestNewWithLogo(t *testing.T) {
+	logo := image.NewNRGBA(image.Rect(0, 0, 16, 16))
+	draw.Draw(logo, logo.Bounds(), image.NewUniform(color.NRGBA{0xff, 0, 0, 0xff}), image.Point{}, draw.Src)
+	data := []byte("https://github.com/shogo82148/qrcode")
+
+	plain, err := New(data, WithLevel(LevelL))
+	if err != nil {
+		t.Fatal(err)
+	}
+	qr, err := New(data, WithLevel(LevelL), WithLogo(image.NewRGBA(image.Rect(0, 0, 10, 10)), 0.25))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if qr.Version == plain.Version && qr.Level == plain.Level {
+		t.Errorf("the version or the level should be raised: version %d, level %s", qr.Version, qr.Level)
+	}
+
+	// the symbol decodes even if the logo area is cleared.
+	img, err := qr.EncodeToBitmap()
+	if err != nil {
+		t.Fatal(err)
+	}
+	area := logoArea(qr.Version, 0.25)
+	clearLogoArea(img, qr.Version, area)
+	got, err := DecodeBitmap(img)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var payload []byte
+	for _, s := range got.Segments {
+		payload = append(payload, s.Data...)
+	}
+	if !bytes.Equal(payload, data) {
+		t.Errorf("got %q, want %q", payload, data)
+	}
+
+	// the logo is drawn in the center.
+	rendered, err := qr.Encode(WithModuleSize(4), WithLogo(logo, 0.25))
+	if err != nil {
+		t.Fatal(err)
+	}
+	center := rendered.Bounds().Dx() / 2
+	c := color.NRGBAModel.Convert(rendered.At(center, center)).(color.NRGBA)
+	if c != (color.NRGBA{0xff, 0, 0, 0xff}) {
+		t.Errorf("unexpected center color: %v", c)
+	}
+}
+
+func TestEncodeWithLogo_TooLarge(t *testing.T) {
+	logo := image.NewGray(image.Rect(0, 0, 16, 16))
+	qr, err := New([]byte("https://github.com/shogo82148/qrcode"), WithLevel(LevelL))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := qr.Encode(WithLogo(logo, 0.3)); err == nil {
+		t.Error("want error, got nil")
+	}
+	if _, err := New([]byte("https://github.com/shogo82148/qrcode"), WithLogo(logo, 0.9)); err == nil {
+		t.Error("want error, got nil")
+	}
+}
+
+func TestCheckLogo(t *testing.T) {
+	tests := []struct {
+		version Version
+		level   Level
+		size    float64
+		ok      bool
+	}{
+		// the logo covers 2 codewords, which is the whole correction capacity of 1-L.
+		{1, LevelL, 0.1, false},
+		{1, LevelM, 0.1, true},
+		// the logo covers 5 codewords in a block of 7-M, which corrects 9 codewords.
+		{7, LevelM, 0.2, false},
+		{7, LevelH, 0.2, true},
+	}
+	for _, tt := range tests {
+		err := checkLogo(tt.version, tt.level, logoArea(tt.version, tt.size))
+		if (err == nil) != tt.ok {
+			t.Errorf("version %d, level %s, size %g: unexpected result: %v", tt.version, tt.level, tt.size, err)
+		}
+	}
+}
+
+func TestClearLogoArea(t *testing.T) {
+	qr := &QRCode{
+		Version:  7,
+		Level:    LevelH,
+		Mask:     MaskAuto,
+		Segments: []Segment{{Mode: ModeBytes, Data: []byte("hello")}},
+	}
+	img, err := qr.EncodeToBitmap()
+	if err != nil {
+		t.Fatal(err)
+	}
+	area := logoArea(qr.Version, 0.25)
+	clearLogoArea(img, qr.Version, area)
+
+	// the alignment pattern at the center is kept.
+	for _, p := range []image.Point{{22, 22}, {20, 20}, {24, 22}} {
+		if !img.BinaryAt(p.X, p.Y) {
+			t.Errorf("the module at %v of the alignment pattern is cleared", p)
+		}
+	}
+	if img.BinaryAt(21, 22) {
+		t.Error("the light module of the alignment pattern is dark")
+	}
+	for _, p := range placement(qr.Version) {
+		if p.In(area) && bool(img.BinaryAt(p.X, p.Y)) {
+			t.Errorf("the data module at %v is not cleared", p)
+		}
+	}
+}
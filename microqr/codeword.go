@@ -0,0 +1,59 @@
+package microqr
+
+import "errors"
+
+// BitPlacement is the position of a bit of a codeword in the symbol.
+type BitPlacement struct {
+	// X and Y are the coordinates of the module.
+	X, Y int
+
+	// Codeword is the index of the codeword in the stream.
+	Codeword int
+
+	// Block is the index of the Reed-Solomon block.
+	// Micro QR Code has only one block, so it is always 0.
+	Block int
+
+	// Index is the index of the codeword in the block.
+	// It is the same as Codeword.
+	Index int
+
+	// Bit is the bit index in the codeword. 7 is the most significant bit.
+	// The last data codewords of M1 and M3 symbols have only 4 bits, from 7 to 4.
+	Bit int
+
+	// Correction reports whether the codeword is an error correction codeword.
+	Correction bool
+}
+
+// CodewordMap returns the placements of the codeword bits
+// in the order in which [QRCode.EncodeToBitmap] places them.
+// The remainder bits are not included.
+func CodewordMap(version Version, level Level) ([]BitPlacement, error) {
+	if version < 1 || version > 4 {
+		return nil, errors.New("microqr: invalid version")
+	}
+	if !level.IsValid() || formatTable[version][level] < 0 {
+		return nil, errors.New("microqr: invalid level")
+	}
+
+	capacity := capacityTable[version][level]
+	points, bits := placement(version, level)
+	ret := make([]BitPlacement, 0, capacity.DataBits+(capacity.Total-capacity.Data)*8)
+	for i, pt := range points {
+		bit := bits[i]
+		idx := bit / 8
+		if idx >= capacity.Total {
+			break
+		}
+		ret = append(ret, BitPlacement{
+			X:          pt.X,
+			Y:          pt.Y,
+			Codeword:   idx,
+			Index:      idx,
+			Bit:        7 - bit%8,
+			Correction: idx >= capacity.Data,
+		})
+	}
+	return ret, nil
+}
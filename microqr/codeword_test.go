@@ -0,0 +1,80 @@
+package microqr
+
+import (
+	"testing"
+
+	internalbitmap "github.com/shogo82148/qrcode/internal/bitmap"
+	"github.com/shogo82148/qrcode/internal/bitstream"
+)
+
+func TestCodewordMap(t *testing.T) {
+	for version := Version(1); version <= 4; version++ {
+		for level := levelMin; level < levelMax; level++ {
+			if formatTable[version][level] < 0 {
+				continue
+			}
+			m, err := CodewordMap(version, level)
+			if err != nil {
+				t.Fatal(err)
+			}
+			capacity := capacityTable[version][level]
+			if want := capacity.DataBits + capacity.Correction*8; len(m) != want {
+				t.Errorf("M%d-%s: got %d bits, want %d", version, level, len(m), want)
+				continue
+			}
+			seen := map[[2]int]bool{}
+			for _, p := range m {
+				key := [2]int{p.Codeword, p.Bit}
+				if seen[key] {
+					t.Errorf("M%d-%s: duplicated bit: %v", version, level, key)
+				}
+				seen[key] = true
+			}
+		}
+	}
+}
+
+func TestCodewordMap_Encode(t *testing.T) {
+	tests := []struct {
+		data  string
+		level Level
+	}{
+		{"12345", LevelCheck},   // M1
+		{"MICRO", LevelL},       // M2-L
+		{"MICRO QR", LevelL},    // M3-L
+		{"MICRO QR", LevelM},    // M3-M
+		{"MICRO QR 12", LevelQ}, // M4-Q
+	}
+	for _, tt := range tests {
+		qr, err := New([]byte(tt.data), WithLevel(tt.level))
+		if err != nil {
+			t.Fatal(err)
+		}
+		qr.Mask = Mask0
+
+		var buf bitstream.Buffer
+		if err := qr.encodeSegments(&buf); err != nil {
+			t.Fatal(err)
+		}
+		stream := buf.Bytes()
+
+		img, err := qr.EncodeToBitmap()
+		if err != nil {
+			t.Fatal(err)
+		}
+		binimg := internalbitmap.Import(img)
+		binimg.Mask(binimg, usedList[qr.Version], maskList[Mask0])
+
+		m, err := CodewordMap(qr.Version, qr.Level)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, p := range m {
+			got := bool(binimg.BinaryAt(p.X, p.Y))
+			want := (stream[p.Codeword]>>p.Bit)&1 != 0
+			if got != want {
+				t.Errorf("M%d-%s: module (%d, %d): got %t, want %t", qr.Version, qr.Level, p.X, p.Y, got, want)
+			}
+		}
+	}
+}
@@ -12,25 +12,50 @@ import (
 	"github.com/shogo82148/qrcode/internal/reedsolomon"
 )
 
-func DecodeBitmap(img *bitmap.Image) (*QRCode, error) {
+// DecodeBitmap decodes the Micro QR Code in img, whose module size is one pixel.
+// img may be modified during decoding.
+// The mirrored, inverted and rotated symbols are also decoded, and they are reported in [QRCode.Orientation].
+// The rules checked on the symbol depend on the decode mode set by [WithDecodeMode].
+func DecodeBitmap(img *bitmap.Image, opts ...DecodeOptions) (*QRCode, error) {
+	var report Report
+	return decodeBitmap(img, &report, newDecodeOptions(opts))
+}
+
+func decodeBitmap(img *bitmap.Image, report *Report, opts decodeOptions) (*QRCode, error) {
 	binimg := internalbitmap.Import(img)
+	orientation := detectOrientation(binimg)
+	binimg = orientation.normalize(binimg)
+	if detectMirrored(binimg) {
+		orientation.Mirrored = true
+		binimg = binimg.Transpose()
+	}
+	report.Orientation = orientation
 
-	// decode format
-	var rawFormat uint
-	for i := 0; i < 8; i++ {
-		if binimg.BinaryAt(8, i+1) {
-			rawFormat |= 1 << i
-		}
-		if binimg.BinaryAt(i+1, 8) {
-			rawFormat |= 1 << (14 - i)
-		}
+	qr, err := decodeSymbol(binimg, report, opts)
+	if err != nil {
+		return nil, err
 	}
+	qr.Orientation = orientation
+	return qr, nil
+}
+
+// decodeSymbol decodes the symbol in the normal orientation.
+func decodeSymbol(binimg *internalbitmap.Image, report *Report, opts decodeOptions) (*QRCode, error) {
+	// decode format
+	rawFormat := readFormat(binimg)
 	version, level, mask, ok := decodeFormat(rawFormat)
 	if !ok {
 		return nil, errors.New("qr code not found")
 	}
+	report.Version = version
+	report.Level = level
+	report.Mask = mask
+	format := encodedFormat[formatTable[version][level]<<2|int(mask)]
+	report.FormatDistance = bits.OnesCount(rawFormat ^ format)
+	if report.FormatDistance != 0 {
+		report.violate(ViolationFormat)
+	}
 
-	w := 8 + 2*int(version)
 	used := usedList[version]
 
 	// mask
@@ -38,62 +63,85 @@ func DecodeBitmap(img *bitmap.Image) (*QRCode, error) {
 
 	qrCapacity := capacityTable[version][level]
 	var buf bitstream.Buffer
-	dy := -1
-	x, y := w, w
-	for {
-		if !used.BinaryAt(x, y) {
-			if binimg.BinaryAt(x, y) {
-				buf.WriteBit(1)
-			} else {
-				buf.WriteBit(0)
-			}
-		}
-		x--
-		if x < 0 {
-			break
-		}
-
-		if !used.BinaryAt(x, y) {
-			if binimg.BinaryAt(x, y) {
-				buf.WriteBit(1)
-			} else {
-				buf.WriteBit(0)
-			}
-		}
-		x, y = x+1, y+dy
-		if y < 0 || y > w {
-			dy *= -1
-			x, y = x-2, y+dy
+	points, bits := placement(version, level)
+	for i, pt := range points {
+		// the last data codeword of M1 and M3 symbols is padded to the codeword boundary.
+		for buf.Len() < bits[i] {
+			buf.WriteBit(0)
 		}
-		if x < 0 {
-			break
-		}
-		if buf.Len() == qrCapacity.DataBits {
-			for buf.Len()%8 != 0 {
-				buf.WriteBit(0)
-			}
+		if binimg.BinaryAt(pt.X, pt.Y) {
+			buf.WriteBit(1)
+		} else {
+			buf.WriteBit(0)
 		}
 	}
 
 	data := buf.Bytes()
-	if err := reedsolomon.Decode(data, qrCapacity.MaxError); err != nil {
+	report.Codewords = append([]byte(nil), data...)
+	report.Blocks = []BlockReport{{
+		DataCodewords:            qrCapacity.Data,
+		ErrorCorrectionCodewords: qrCapacity.Total - qrCapacity.Data,
+		Errors:                   -1,
+	}}
+	if err := reedsolomon.Decode(data, qrCapacity.Correction); err != nil {
 		return nil, err
 	}
+	errs := countErrors(report.Codewords, data)
+	if errs > qrCapacity.MaxError {
+		return nil, errors.New("microqr: too many errors")
+	}
+	report.Blocks[0].Errors = errs
 	data = data[:qrCapacity.Data]
-	buf0 := bitstream.NewBuffer(data)
+	report.Data = data
+	r := newSegmentReader(bitstream.NewBuffer(data), qrCapacity.DataBits, opts.Mode, report)
 
+	var qr *QRCode
+	var terminator int
+	var err error
 	switch version {
 	case 1:
-		return decodeVersion1(buf0, mask, level)
+		qr, terminator, err = decodeVersion1(r, mask, level)
 	case 2:
-		return decodeVersion2(buf0, mask, level)
+		qr, terminator, err = decodeVersion2(r, mask, level)
 	case 3:
-		return decodeVersion3(buf0, mask, level)
+		qr, terminator, err = decodeVersion3(r, mask, level)
 	case 4:
-		return decodeVersion4(buf0, mask, level)
+		qr, terminator, err = decodeVersion4(r, mask, level)
 	default:
 		panic("invalid version: " + strconv.Itoa(int(version)))
 	}
+	if err != nil {
+		return nil, err
+	}
+	if r.Truncated {
+		// the truncated segment takes the rest of the stream.
+		r.End = r.DataBits
+	}
+	if r.UnknownMode {
+		report.violate(ViolationMode)
+	}
+	report.checkPadding(terminator, terminatorLength[version], r.End, qrCapacity.DataBits)
+	if opts.Mode == DecodeModeStrict && len(report.Violations) > 0 {
+		return nil, &ViolationError{Violations: report.Violations}
+	}
+	return qr, nil
+}
+
+// terminatorLength is the length of the terminator in bits for each version.
+var terminatorLength = [5]int{0, 3, 5, 7, 9}
+
+// readFormat reads the format information.
+func readFormat(img *internalbitmap.Image) uint {
+	var rawFormat uint
+	for i := 0; i < 8; i++ {
+		if img.BinaryAt(8, i+1) {
+			rawFormat |= 1 << i
+		}
+		if img.BinaryAt(i+1, 8) {
+			rawFormat |= 1 << (14 - i)
+		}
+	}
+	return rawFormat
 }
 
 func decodeFormat(raw uint) (Version, Level, Mask, bool) {
@@ -113,22 +161,27 @@ func decodeFormat(raw uint) (Version, Level, Mask, bool) {
 	return format.version, format.level, Mask(idx & 0b11), true
 }
 
-func decodeVersion1(buf *bitstream.Buffer, mask Mask, level Level) (*QRCode, error) {
+func decodeVersion1(r *bitstream.SegmentReader, mask Mask, level Level) (*QRCode, int, error) {
+	buf := r.Buf
 	segments := make([]Segment, 0)
-	for {
-		length, err := buf.ReadBits(3)
+	terminator := -1
+	for !r.Truncated {
+		offset := buf.Offset()
+		r.End = offset
+		length, err := r.Length(3, bitstream.NumericBits)
 		if err != nil {
 			if errors.Is(err, io.EOF) {
 				break
 			}
-			return nil, err
+			return nil, 0, err
 		}
 		if length == 0 { // terminate pattern
+			terminator = offset
 			break
 		}
 		data := make([]byte, length)
 		if err := bitstream.DecodeNumeric(buf, data); err != nil {
-			return nil, err
+			return nil, 0, err
 		}
 		segments = append(segments, Segment{
 			Mode: ModeNumeric,
@@ -140,53 +193,63 @@ func decodeVersion1(buf *bitstream.Buffer, mask Mask, level Level) (*QRCode, err
 		Level:    level,
 		Mask:     mask,
 		Segments: segments,
-	}, nil
+	}, terminator, nil
 }
 
-func decodeVersion2(buf *bitstream.Buffer, mask Mask, level Level) (*QRCode, error) {
+func decodeVersion2(r *bitstream.SegmentReader, mask Mask, level Level) (*QRCode, int, error) {
+	buf := r.Buf
 	segments := make([]Segment, 0)
+	terminator := -1
 
 LOOP:
-	for {
+	for !r.Truncated {
+		offset := buf.Offset()
+		r.End = offset
 		mode, err := buf.ReadBits(1)
 		if err != nil {
 			if errors.Is(err, io.EOF) {
 				break
 			}
-			return nil, err
+			return nil, 0, err
 		}
 
 		var data []byte
 		switch Mode(mode) {
 		case ModeNumeric:
-			length, err := buf.ReadBits(4)
+			length, err := r.Length(4, bitstream.NumericBits)
 			if err != nil {
 				if errors.Is(err, io.EOF) {
 					break LOOP
 				}
-				return nil, err
+				return nil, 0, err
 			}
 			if length == 0 { // terminate pattern
+				terminator = offset
 				break LOOP
 			}
 			data = make([]byte, length)
 			if err := bitstream.DecodeNumeric(buf, data); err != nil {
-				return nil, err
+				return nil, 0, err
 			}
 		case ModeAlphanumeric:
-			length, err := buf.ReadBits(3)
+			length, err := r.Length(3, bitstream.AlphanumericBits)
 			if err != nil {
 				if errors.Is(err, io.EOF) {
 					break LOOP
 				}
-				return nil, err
+				return nil, 0, err
 			}
 			data = make([]byte, length)
 			if err := bitstream.DecodeAlphanumeric(buf, data); err != nil {
-				return nil, err
+				return nil, 0, err
 			}
 		default:
-			return nil, errors.New("qrcode: unknown mode: " + strconv.Itoa(int(mode)))
+			if r.Mode == bitstream.DecodeModeDefault {
+				return nil, 0, errors.New("qrcode: unknown mode: " + strconv.Itoa(int(mode)))
+			}
+			r.UnknownMode = true
+			// the rest of the stream can't be decoded.
+			break LOOP
 		}
 		segments = append(segments, Segment{
 			Mode: Mode(mode),
@@ -198,77 +261,87 @@ LOOP:
 		Level:    level,
 		Mask:     mask,
 		Segments: segments,
-	}, nil
+	}, terminator, nil
 }
 
-func decodeVersion3(buf *bitstream.Buffer, mask Mask, level Level) (*QRCode, error) {
+func decodeVersion3(r *bitstream.SegmentReader, mask Mask, level Level) (*QRCode, int, error) {
+	buf := r.Buf
 	segments := make([]Segment, 0)
+	terminator := -1
 
 LOOP:
-	for {
+	for !r.Truncated {
+		offset := buf.Offset()
+		r.End = offset
 		mode, err := buf.ReadBits(2)
 		if err != nil {
 			if errors.Is(err, io.EOF) {
 				break
 			}
-			return nil, err
+			return nil, 0, err
 		}
 
 		var data []byte
 		switch Mode(mode) {
 		case ModeNumeric:
-			length, err := buf.ReadBits(5)
+			length, err := r.Length(5, bitstream.NumericBits)
 			if err != nil {
 				if errors.Is(err, io.EOF) {
 					break LOOP
 				}
-				return nil, err
+				return nil, 0, err
 			}
 			if length == 0 { // terminate pattern
+				terminator = offset
 				break LOOP
 			}
 			data = make([]byte, length)
 			if err := bitstream.DecodeNumeric(buf, data); err != nil {
-				return nil, err
+				return nil, 0, err
 			}
 		case ModeAlphanumeric:
-			length, err := buf.ReadBits(4)
+			length, err := r.Length(4, bitstream.AlphanumericBits)
 			if err != nil {
 				if errors.Is(err, io.EOF) {
 					break LOOP
 				}
-				return nil, err
+				return nil, 0, err
 			}
 			data = make([]byte, length)
 			if err := bitstream.DecodeAlphanumeric(buf, data); err != nil {
-				return nil, err
+				return nil, 0, err
 			}
 		case ModeBytes:
-			length, err := buf.ReadBits(4)
+			length, err := r.Length(4, bitstream.BytesBits)
 			if err != nil {
 				if errors.Is(err, io.EOF) {
 					break LOOP
 				}
-				return nil, err
+				return nil, 0, err
 			}
 			data = make([]byte, length)
 			if err := bitstream.DecodeBytes(buf, data); err != nil {
-				return nil, err
+				return nil, 0, err
 			}
 		case ModeKanji:
-			length, err := buf.ReadBits(3)
+			length, err := r.Length(3, bitstream.KanjiBits)
 			if err != nil {
 				if errors.Is(err, io.EOF) {
 					break LOOP
 				}
-				return nil, err
+				return nil, 0, err
 			}
-			data, err = bitstream.DecodeKanji(buf, int(length))
+			data, err = r.Kanji(length)
 			if err != nil {
-				return nil, err
+				return nil, 0, err
 			}
 		default:
-			return nil, errors.New("qrcode: unknown mode: " + strconv.Itoa(int(mode)))
+			if r.Mode == bitstream.DecodeModeDefault {
+				return nil, 0, errors.New("qrcode: unknown mode: " + strconv.Itoa(int(mode)))
+			}
+			r.UnknownMode = true
+			// the rest of the stream can't be decoded.
+			break LOOP
 		}
 		segments = append(segments, Segment{
 			Mode: Mode(mode),
@@ -280,77 +353,87 @@ LOOP:
 		Level:    level,
 		Mask:     mask,
 		Segments: segments,
-	}, nil
+	}, terminator, nil
 }
 
-func decodeVersion4(buf *bitstream.Buffer, mask Mask, level Level) (*QRCode, error) {
+func decodeVersion4(r *bitstream.SegmentReader, mask Mask, level Level) (*QRCode, int, error) {
+	buf := r.Buf
 	segments := make([]Segment, 0)
+	terminator := -1
 
 LOOP:
-	for {
+	for !r.Truncated {
+		offset := buf.Offset()
+		r.End = offset
 		mode, err := buf.ReadBits(3)
 		if err != nil {
 			if errors.Is(err, io.EOF) {
 				break
 			}
-			return nil, err
+			return nil, 0, err
 		}
 
 		var data []byte
 		switch Mode(mode) {
 		case ModeNumeric:
-			length, err := buf.ReadBits(6)
+			length, err := r.Length(6, bitstream.NumericBits)
 			if err != nil {
 				if errors.Is(err, io.EOF) {
 					break LOOP
 				}
-				return nil, err
+				return nil, 0, err
 			}
 			if length == 0 { // terminate pattern
+				terminator = offset
 				break LOOP
 			}
 			data = make([]byte, length)
 			if err := bitstream.DecodeNumeric(buf, data); err != nil {
-				return nil, err
+				return nil, 0, err
 			}
 		case ModeAlphanumeric:
-			length, err := buf.ReadBits(5)
+			length, err := r.Length(5, bitstream.AlphanumericBits)
 			if err != nil {
 				if errors.Is(err, io.EOF) {
 					break LOOP
 				}
-				return nil, err
+				return nil, 0, err
 			}
 			data = make([]byte, length)
 			if err := bitstream.DecodeAlphanumeric(buf, data); err != nil {
-				return nil, err
+				return nil, 0, err
 			}
 		case ModeBytes:
-			length, err := buf.ReadBits(5)
+			length, err := r.Length(5, bitstream.BytesBits)
 			if err != nil {
 				if errors.Is(err, io.EOF) {
 					break LOOP
 				}
-				return nil, err
+				return nil, 0, err
 			}
 			data = make([]byte, length)
 			if err := bitstream.DecodeBytes(buf, data); err != nil {
-				return nil, err
+				return nil, 0, err
 			}
 		case ModeKanji:
-			length, err := buf.ReadBits(4)
+			length, err := r.Length(4, bitstream.KanjiBits)
 			if err != nil {
 				if errors.Is(err, io.EOF) {
 					break LOOP
 				}
-				return nil, err
+				return nil, 0, err
 			}
-			data, err = bitstream.DecodeKanji(buf, int(length))
+			data, err = r.Kanji(length)
 			if err != nil {
-				return nil, err
+				return nil, 0, err
 			}
 		default:
-			return nil, errors.New("qrcode: unknown mode: " + strconv.Itoa(int(mode)))
+			if r.Mode == bitstream.DecodeModeDefault {
+				return nil, 0, errors.New("qrcode: unknown mode: " + strconv.Itoa(int(mode)))
+			}
+			r.UnknownMode = true
+			// the rest of the stream can't be decoded.
+			break LOOP
 		}
 		if len(data) == 0 {
 			continue
@@ -365,5 +448,5 @@ LOOP:
 		Level:    level,
 		Mask:     mask,
 		Segments: segments,
-	}, nil
+	}, terminator, nil
 }
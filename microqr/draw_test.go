@@ -0,0 +1,39 @@
+package microqr
+
+import (
+	"image"
+	"image/color"
+	"testing"
+)
+
+func TestDrawTransform_Inverted(t *testing.T) {
+	qr, err := New([]byte("0123"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := qr.EncodeToBitmap()
+	if err != nil {
+		t.Fatal(err)
+	}
+	n := want.Bounds().Dx()
+
+	dst := image.NewRGBA(image.Rect(0, 0, 200, 200))
+	red := color.NRGBA{0xff, 0, 0, 0xff}
+	m := [6]float64{8, 0, 20, 0, 8, 20}
+	if err := qr.DrawTransform(dst, m, WithForeground(red), WithInverted(true)); err != nil {
+		t.Fatal(err)
+	}
+
+	// the light modules and the quiet zone are red, and the dark modules are white.
+	if got := dst.RGBAAt(20-4, 20-4); got != (color.RGBA{0xff, 0, 0, 0xff}) {
+		t.Errorf("the quiet zone: got %v, want red", got)
+	}
+	for y := 0; y < n; y++ {
+		for x := 0; x < n; x++ {
+			c := dst.RGBAAt(20+x*8+4, 20+y*8+4)
+			if got := c.G > 0x80; got != bool(want.BinaryAt(x, y)) {
+				t.Fatalf("module (%d, %d) mismatch: %v", x, y, c)
+			}
+		}
+	}
+}
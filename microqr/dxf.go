@@ -0,0 +1,24 @@
+package microqr
+
+import (
+	"io"
+
+	"github.com/shogo82148/qrcode/internal/vector"
+)
+
+// EncodeDXF encodes Micro QR Code into a DXF drawing for laser engraving and CNC marking, and writes it to w.
+// The adjacent dark modules are merged into closed polylines in millimeters.
+// The module size is set by [WithModuleSizeMM], and the default size is 1 mm.
+// The fill hatching is enabled by [WithHatch].
+func (qr *QRCode) EncodeDXF(w io.Writer, opts ...EncodeOptions) error {
+	myopts := newEncodeOptions(opts...)
+	binimg, err := qr.EncodeToBitmap()
+	if err != nil {
+		return err
+	}
+	moduleSize := myopts.ModuleSizeMM
+	if moduleSize <= 0 {
+		moduleSize = 1
+	}
+	return vector.EncodeDXF(w, binimg, myopts.QuietZone, moduleSize, myopts.Hatch)
+}
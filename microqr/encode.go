@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"image"
+	"image/color"
 	"math"
 	"unicode/utf8"
 
@@ -14,6 +15,7 @@ import (
 	"github.com/shogo82148/go-imaging/srgb"
 	internalbitmap "github.com/shogo82148/qrcode/internal/bitmap"
 	"github.com/shogo82148/qrcode/internal/bitstream"
+	"github.com/shogo82148/qrcode/internal/raster"
 	"github.com/shogo82148/qrcode/internal/reedsolomon"
 )
 
@@ -416,11 +418,25 @@ LOOP:
 type EncodeOptions func(opts *encodeOptions)
 
 type encodeOptions struct {
-	QuietZone  int
-	ModuleSize float64
-	Level      Level
-	Kanji      bool
-	Width      int
+	QuietZone         int
+	ModuleSize        float64
+	Level             Level
+	Kanji             bool
+	Width             int
+	DPI               float64
+	ModuleSizeMM      float64
+	BarWidthReduction int
+	Hatch             float64
+	Base              float64
+	Height            float64
+	Engraved          bool
+	Inverted          bool
+	Foreground        color.Color
+	Background        color.Color
+	Rotation          float64
+	Plain             bool
+	TextBlack         string
+	TextWhite         string
 }
 
 func newEncodeOptions(opts ...EncodeOptions) encodeOptions {
@@ -430,6 +446,10 @@ func newEncodeOptions(opts ...EncodeOptions) encodeOptions {
 		Level:      LevelQ,
 		Kanji:      true,
 		Width:      0,
+		TextBlack:  "#",
+		TextWhite:  " ",
+		Base:       2,
+		Height:     1,
 	}
 	for _, o := range opts {
 		o(&myopts)
@@ -477,6 +497,121 @@ func WithWidth(width int) EncodeOptions {
 	}
 }
 
+// WithDPI sets the resolution of the image in dots per inch.
+// It is recorded in the output formats that support it, such as PNG.
+// The default value is 0, which means that the resolution is not recorded.
+func WithDPI(dpi float64) EncodeOptions {
+	return func(opts *encodeOptions) {
+		opts.DPI = dpi
+	}
+}
+
+// WithModuleSizeMM sets the module size in millimeters for printing.
+// It requires the resolution set by [WithDPI],
+// and the module size is snapped to whole printer dots.
+// It overrides [WithModuleSize].
+func WithModuleSizeMM(mm float64) EncodeOptions {
+	return func(opts *encodeOptions) {
+		opts.ModuleSizeMM = mm
+	}
+}
+
+// WithBarWidthReduction sets the number of pixels (printer dots)
+// removed from the width of dark modules to compensate the dot gain in printing.
+// The edges of dark modules next to light modules are shrunk,
+// and half of the reduction is applied to each side.
+// The reduction is less than the module size.
+// The default value is 0.
+func WithBarWidthReduction(dots int) EncodeOptions {
+	return func(opts *encodeOptions) {
+		opts.BarWidthReduction = dots
+	}
+}
+
+// WithHatch sets the line spacing in millimeters of the fill hatching in vector outputs.
+// The default value is 0, and the dark modules are not filled.
+func WithHatch(spacing float64) EncodeOptions {
+	return func(opts *encodeOptions) {
+		opts.Hatch = spacing
+	}
+}
+
+// WithExtrusion sets the thickness of the base plate and the height of the modules in millimeters for 3D models.
+// The default values are 2 mm and 1 mm, and both must be positive.
+func WithExtrusion(base, height float64) EncodeOptions {
+	return func(opts *encodeOptions) {
+		opts.Base = base
+		opts.Height = height
+	}
+}
+
+// WithEngraved sets whether the dark modules are engraved into 3D models.
+// The default value is false, and the dark modules are raised.
+// If it's enabled, the light modules and the quiet zone are raised instead.
+func WithEngraved(engraved bool) EncodeOptions {
+	return func(opts *encodeOptions) {
+		opts.Engraved = engraved
+	}
+}
+
+// WithInverted sets whether the symbol is rendered light on dark.
+// The default value is false.
+// If it's enabled, the dark modules and the light modules are swapped in the images and the text,
+// and the quiet zone is dark.
+// Inverted symbols suit laser etching on dark materials and backlit displays.
+func WithInverted(inverted bool) EncodeOptions {
+	return func(opts *encodeOptions) {
+		opts.Inverted = inverted
+	}
+}
+
+// WithForeground sets the color of dark modules for [QRCode.Draw] and [QRCode.DrawTransform].
+// The default color is black.
+func WithForeground(c color.Color) EncodeOptions {
+	return func(opts *encodeOptions) {
+		opts.Foreground = c
+	}
+}
+
+// WithBackground sets the color of light modules and the quiet zone for [QRCode.Draw] and [QRCode.DrawTransform].
+// The default color is white.
+func WithBackground(c color.Color) EncodeOptions {
+	return func(opts *encodeOptions) {
+		opts.Background = c
+	}
+}
+
+// WithRotation sets the clockwise rotation in degrees for [QRCode.Draw].
+// The default value is 0.
+func WithRotation(degrees float64) EncodeOptions {
+	return func(opts *encodeOptions) {
+		opts.Rotation = degrees
+	}
+}
+
+// printing reports whether the options require the rendering for printing.
+func (opts *encodeOptions) printing() bool {
+	return opts.ModuleSizeMM > 0 || opts.BarWidthReduction != 0
+}
+
+// WithPlain sets whether the plain (ASCII) formats are used for Netpbm images and STL models.
+// The default value is false, and the raw (binary) formats are used.
+func WithPlain(plain bool) EncodeOptions {
+	return func(opts *encodeOptions) {
+		opts.Plain = plain
+	}
+}
+
+// WithTextChars sets the characters of black and white modules for the text output.
+// The default characters are "#" and " ".
+// Both should have the same width, e.g. "██" and "  " make modules look square in terminals.
+func WithTextChars(black, white string) EncodeOptions {
+	return func(opts *encodeOptions) {
+		opts.TextBlack = black
+		opts.TextWhite = white
+	}
+}
+
 func Encode(data []byte, opts ...EncodeOptions) (image.Image, error) {
 	qr, err := New(data, opts...)
 	if err != nil {
@@ -492,6 +627,13 @@ func (qr *QRCode) Encode(opts ...EncodeOptions) (image.Image, error) {
 	if err != nil {
 		return nil, err
 	}
+	if myopts.printing() {
+		l, err := newLayout(binimg, myopts)
+		if err != nil {
+			return nil, err
+		}
+		return raster.Gray(binimg, l), nil
+	}
 
 	w := binimg.Bounds().Dx() + myopts.QuietZone*2
 
@@ -501,7 +643,7 @@ func (qr *QRCode) Encode(opts ...EncodeOptions) (image.Image, error) {
 	white := fp16color.NewNRGBAh(1, 1, 1, 1)
 	for y := 0; y < w; y++ {
 		for x := 0; x < w; x++ {
-			c := binimg.BinaryAt(x-myopts.QuietZone, y-myopts.QuietZone)
+			c := binimg.BinaryAt(x-myopts.QuietZone, y-myopts.QuietZone) != bitmap.Color(myopts.Inverted)
 			if c {
 				src.SetNRGBAh(x, y, black)
 			} else {
@@ -539,50 +681,21 @@ func (qr *QRCode) EncodeToBitmap() (*bitmap.Image, error) {
 		return nil, err
 	}
 
-	w := 8 + 2*int(qr.Version)
 	img := baseList[qr.Version].Clone()
 	used := usedList[qr.Version]
-
-	dy := -1
-	x, y := w, w
-	readBits := 0
-	capacity := capacityTable[qr.Version][qr.Level]
-	for {
-		if !used.BinaryAt(x, y) {
-			readBits++
-			bit, err := buf.ReadBit()
-			if err != nil {
+	points, bits := placement(qr.Version, qr.Level)
+	for i, pt := range points {
+		// skip the bits up to the codeword boundary after the last data codeword.
+		for buf.Offset() < bits[i] {
+			if _, err := buf.ReadBit(); err != nil {
 				break
 			}
-			img.SetBinary(x, y, bit != 0)
 		}
-		x--
-		if x < 0 {
+		bit, err := buf.ReadBit()
+		if err != nil {
 			break
 		}
-
-		if !used.BinaryAt(x, y) {
-			readBits++
-			bit, err := buf.ReadBit()
-			if err != nil {
-				break
-			}
-			img.SetBinary(x, y, bit != 0)
-		}
-		x, y = x+1, y+dy
-		if y < 0 || y > w {
-			dy *= -1
-			x, y = x-2, y+dy
-		}
-		if x < 0 {
-			break
-		}
-		if readBits == capacity.DataBits {
-			for readBits%8 != 0 {
-				readBits++
-				buf.ReadBit()
-			}
-		}
+		img.SetBinary(pt.X, pt.Y, bit != 0)
 	}
 
 	mask := qr.Mask
@@ -0,0 +1,24 @@
+package microqr
+
+import (
+	"io"
+
+	"github.com/shogo82148/qrcode/internal/raster"
+)
+
+// EncodeESCPOS encodes Micro QR Code into ESC/POS commands for thermal receipt printers, and writes them to w.
+// The printers don't support Micro QR Code natively,
+// so the image rendered in the same way as [QRCode.EncodePaletted]
+// is sent as a raster bit image (GS v 0), and the pixels are the printer dots.
+func (qr *QRCode) EncodeESCPOS(w io.Writer, opts ...EncodeOptions) error {
+	myopts := newEncodeOptions(opts...)
+	binimg, err := qr.EncodeToBitmap()
+	if err != nil {
+		return err
+	}
+	l, err := newLayout(binimg, myopts)
+	if err != nil {
+		return err
+	}
+	return raster.EncodeESCPOS(w, binimg, l)
+}
@@ -0,0 +1,53 @@
+package microqr
+
+import (
+	"image"
+	"image/color"
+
+	"github.com/shogo82148/qrcode/internal/raster"
+)
+
+// Image is a 1-bit image of Micro QR Code that computes each pixel from the module matrix on demand.
+// It implements [image.PalettedImage], and it doesn't allocate the pixels.
+// The pixels are the same as the image returned by [QRCode.EncodePaletted] with the same options.
+type Image struct {
+	lazy *raster.Lazy
+}
+
+var _ image.PalettedImage = (*Image)(nil)
+
+// Image returns a lazily rendered image of Micro QR Code.
+// The module matrix is encoded once, and the pixels are computed when they are read.
+func (qr *QRCode) Image(opts ...EncodeOptions) (*Image, error) {
+	myopts := newEncodeOptions(opts...)
+	binimg, err := qr.EncodeToBitmap()
+	if err != nil {
+		return nil, err
+	}
+	l, err := newLayout(binimg, myopts)
+	if err != nil {
+		return nil, err
+	}
+	return &Image{lazy: raster.NewLazy(binimg, l)}, nil
+}
+
+// ColorModel implements [image.Image].
+func (img *Image) ColorModel() color.Model {
+	return img.lazy.ColorModel()
+}
+
+// Bounds implements [image.Image].
+func (img *Image) Bounds() image.Rectangle {
+	return img.lazy.Bounds()
+}
+
+// At implements [image.Image].
+func (img *Image) At(x, y int) color.Color {
+	return img.lazy.At(x, y)
+}
+
+// ColorIndexAt implements [image.PalettedImage].
+// The index 0 is white and the index 1 is black.
+func (img *Image) ColorIndexAt(x, y int) uint8 {
+	return img.lazy.ColorIndexAt(x, y)
+}
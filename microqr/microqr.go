@@ -9,6 +9,10 @@ type QRCode struct {
 	Level    Level
 	Mask     Mask
 	Segments []Segment
+
+	// Orientation is the orientation of the decoded symbol.
+	// The encoders ignore it. Use [WithInverted] to render inverted symbols.
+	Orientation Orientation
 }
 
 // Version is a version of microQR code.
@@ -0,0 +1,68 @@
+package microqr
+
+import (
+	"io"
+
+	"github.com/shogo82148/go-imaging/bitmap"
+	"github.com/shogo82148/qrcode/internal/raster"
+)
+
+// EncodePBM encodes Micro QR Code into a PBM image and writes it to w.
+// The image is rendered in the same way as [QRCode.EncodePaletted].
+// The raw format (P4) is used unless [WithPlain] is enabled.
+func (qr *QRCode) EncodePBM(w io.Writer, opts ...EncodeOptions) error {
+	myopts := newEncodeOptions(opts...)
+	binimg, err := qr.EncodeToBitmap()
+	if err != nil {
+		return err
+	}
+	l, err := newLayout(binimg, myopts)
+	if err != nil {
+		return err
+	}
+	return raster.EncodePBM(w, binimg, l, myopts.Plain)
+}
+
+// EncodePGM encodes Micro QR Code into a PGM image and writes it to w.
+// The image is rendered in the same way as [QRCode.EncodePaletted].
+// The raw format (P5) is used unless [WithPlain] is enabled.
+func (qr *QRCode) EncodePGM(w io.Writer, opts ...EncodeOptions) error {
+	myopts := newEncodeOptions(opts...)
+	binimg, err := qr.EncodeToBitmap()
+	if err != nil {
+		return err
+	}
+	l, err := newLayout(binimg, myopts)
+	if err != nil {
+		return err
+	}
+	return raster.EncodePGM(w, binimg, l, myopts.Plain)
+}
+
+// EncodeXBM encodes Micro QR Code into an X BitMap image and writes it to w.
+// The image is rendered in the same way as [QRCode.EncodePaletted].
+// The C identifiers are prefixed with "microqr".
+func (qr *QRCode) EncodeXBM(w io.Writer, opts ...EncodeOptions) error {
+	myopts := newEncodeOptions(opts...)
+	binimg, err := qr.EncodeToBitmap()
+	if err != nil {
+		return err
+	}
+	l, err := newLayout(binimg, myopts)
+	if err != nil {
+		return err
+	}
+	return raster.EncodeXBM(w, binimg, l, "microqr")
+}
+
+// ReadBitmap reads a PBM or PGM image of Micro QR Code from r,
+// and returns the bitmap that [DecodeBitmap] accepts.
+// The quiet zone is removed, and the image must have whole pixels per module,
+// such as the images written by [QRCode.EncodePBM] and [QRCode.EncodePGM].
+func ReadBitmap(r io.Reader) (*bitmap.Image, error) {
+	img, err := raster.DecodeNetpbm(r)
+	if err != nil {
+		return nil, err
+	}
+	return raster.Trim(img)
+}
@@ -0,0 +1,29 @@
+package microqr
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestReadBitmap(t *testing.T) {
+	qr, err := New([]byte("MICRO QR"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the variants of the formats are tested in internal/raster.
+	var buf bytes.Buffer
+	if err := qr.EncodePBM(&buf, WithModuleSize(3)); err != nil {
+		t.Fatal(err)
+	}
+	img, err := ReadBitmap(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := DecodeBitmap(img)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got.Segments[0].Data) != "MICRO QR" {
+		t.Errorf("unexpected data: %q", got.Segments[0].Data)
+	}
+}
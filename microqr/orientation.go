@@ -0,0 +1,92 @@
+package microqr
+
+import (
+	"math/bits"
+
+	internalbitmap "github.com/shogo82148/qrcode/internal/bitmap"
+	"github.com/shogo82148/qrcode/internal/grading"
+)
+
+// Orientation is the orientation of a symbol detected by the decoders.
+// The zero value is the normal orientation, dark on light, upright and not mirrored.
+type Orientation struct {
+	// Mirrored reports whether the symbol is mirrored,
+	// e.g. read through glass or from the back of a transparent sheet.
+	// The rows and the columns of the mirrored symbol are swapped.
+	Mirrored bool
+
+	// Inverted reports whether the symbol is light on dark.
+	Inverted bool
+
+	// Rotation is the clockwise rotation of the symbol in degrees, 0, 90, 180 or 270.
+	// The mirrored symbol is rotated after mirroring.
+	Rotation int
+}
+
+// normalize returns img in the normal orientation.
+// img is modified.
+func (o Orientation) normalize(img *internalbitmap.Image) *internalbitmap.Image {
+	if o.Inverted {
+		img.Invert()
+	}
+	if o.Rotation != 0 {
+		img = img.Rotate(360 - o.Rotation)
+	}
+	if o.Mirrored {
+		img = img.Transpose()
+	}
+	return img
+}
+
+// grading returns o for the grading of the print quality.
+func (o Orientation) grading() grading.Orientation {
+	return grading.Orientation{Mirrored: o.Mirrored, Inverted: o.Inverted, Rotation: o.Rotation}
+}
+
+// detectOrientation detects the colors and the rotation of the symbol in img
+// from the position of the finder pattern.
+// Mirroring can't be detected from it, see detectMirrored.
+func detectOrientation(img *internalbitmap.Image) Orientation {
+	n := img.Rect.Dx()
+	if n < 7 || img.Rect.Dy() != n {
+		return Orientation{}
+	}
+
+	// the corners in the clockwise order from the top left.
+	corners := [4][2]int{{0, 0}, {n - 7, 0}, {n - 7, n - 7}, {0, n - 7}}
+	var o Orientation
+	best := 7*7 + 1
+	for i, c := range corners {
+		m := img.FinderMismatches(c[0], c[1])
+		if m < best {
+			best = m
+			o = Orientation{Rotation: i * 90}
+		}
+		if 7*7-m < best {
+			best = 7*7 - m
+			o = Orientation{Inverted: true, Rotation: i * 90}
+		}
+	}
+	return o
+}
+
+// detectMirrored reports whether the symbol in img is mirrored,
+// where img is upright and the colors are normal.
+// The rows and the columns of the format information are swapped by mirroring,
+// and the bits read in the reverse order are at least 3 bits away from any valid format information.
+// So the format information is read in both ways, and the closer one to a valid format information is taken.
+func detectMirrored(img *internalbitmap.Image) bool {
+	distance := func(img *internalbitmap.Image) int {
+		return formatDistance(readFormat(img))
+	}
+	return distance(img.Transpose()) < distance(img)
+}
+
+// formatDistance returns the Hamming distance from raw to the nearest valid format information.
+func formatDistance(raw uint) int {
+	d := 15
+	for _, pattern := range encodedFormat {
+		d = min(d, bits.OnesCount(pattern^raw))
+	}
+	return d
+}
@@ -0,0 +1,136 @@
+package microqr
+
+import (
+	"bytes"
+	"testing"
+
+	internalbitmap "github.com/shogo82148/qrcode/internal/bitmap"
+)
+
+func TestDecodeBitmap_Orientation(t *testing.T) {
+	tests := []Orientation{
+		{},
+		{Mirrored: true},
+		{Inverted: true},
+		{Mirrored: true, Inverted: true},
+	}
+	for _, want := range tests {
+		for _, data := range []string{"12345", "HELLO WORLD"} {
+			qr, err := New([]byte(data))
+			if err != nil {
+				t.Fatal(err)
+			}
+			img, err := qr.EncodeToBitmap()
+			if err != nil {
+				t.Fatal(err)
+			}
+			binimg := internalbitmap.Import(img)
+			if want.Inverted {
+				binimg.Invert()
+			}
+			if want.Mirrored {
+				binimg = binimg.Transpose()
+			}
+
+			got, err := DecodeBitmap(binimg.Export())
+			if err != nil {
+				t.Errorf("%+v: %v", want, err)
+				continue
+			}
+			if got.Orientation != want {
+				t.Errorf("got %+v, want %+v", got.Orientation, want)
+			}
+			if string(got.Segments[0].Data) != data {
+				t.Errorf("got %q, want %q", got.Segments[0].Data, data)
+			}
+		}
+	}
+}
+
+func TestWithInverted(t *testing.T) {
+	qr, err := New([]byte("12345"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	img, err := qr.EncodeGray(WithInverted(true), WithModuleSize(4))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the quiet zone is dark, and the corner of the finder pattern is light.
+	if c := img.GrayAt(0, 0).Y; c != 0x00 {
+		t.Errorf("quiet zone: got %02x, want 00", c)
+	}
+	if c := img.GrayAt(4*4, 4*4).Y; c != 0xff {
+		t.Errorf("finder pattern: got %02x, want ff", c)
+	}
+
+	var buf bytes.Buffer
+	if err := qr.EncodeText(&buf, WithInverted(true), WithQuietZone(1)); err != nil {
+		t.Fatal(err)
+	}
+	lines := bytes.Split(buf.Bytes(), []byte("\n"))
+	if len(bytes.Trim(lines[0], "#")) != 0 || !bytes.HasPrefix(lines[1], []byte("# ")) {
+		t.Errorf("unexpected text: %q", buf.String())
+	}
+}
+
+func TestDecodeBitmap_Rotation(t *testing.T) {
+	data := "12345"
+	qr, err := New([]byte(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	img, err := qr.EncodeToBitmap()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, mirrored := range []bool{false, true} {
+		for _, inverted := range []bool{false, true} {
+			for _, rotation := range []int{0, 90, 180, 270} {
+				want := Orientation{Mirrored: mirrored, Inverted: inverted, Rotation: rotation}
+				binimg := internalbitmap.Import(img).Clone()
+				if inverted {
+					binimg.Invert()
+				}
+				if mirrored {
+					binimg = binimg.Transpose()
+				}
+				binimg = binimg.Rotate(rotation)
+
+				got, err := DecodeBitmap(binimg.Export())
+				if err != nil {
+					t.Errorf("%+v: %v", want, err)
+					continue
+				}
+				if got.Orientation != want {
+					t.Errorf("got %+v, want %+v", got.Orientation, want)
+				}
+				if string(got.Segments[0].Data) != data {
+					t.Errorf("got %q, want %q", got.Segments[0].Data, data)
+				}
+			}
+		}
+	}
+}
+
+func TestDetectMirrored(t *testing.T) {
+	for _, level := range []Level{LevelCheck, LevelL, LevelM, LevelQ} {
+		qr, err := New([]byte("12345"), WithLevel(level))
+		if err != nil {
+			t.Fatal(err)
+		}
+		img, err := qr.EncodeToBitmap()
+		if err != nil {
+			t.Fatal(err)
+		}
+		binimg := internalbitmap.Import(img)
+		if detectMirrored(binimg) {
+			t.Errorf("level %s: want not mirrored", level)
+		}
+		if !detectMirrored(binimg.Transpose()) {
+			t.Errorf("level %s: want mirrored", level)
+		}
+	}
+}
@@ -0,0 +1,63 @@
+package microqr
+
+import (
+	"bytes"
+	"image/color"
+	"testing"
+
+	"github.com/shogo82148/qrcode/internal/overlay"
+)
+
+func TestOverlay(t *testing.T) {
+	qr, err := New([]byte("HELLO"), WithLevel(LevelM))
+	if err != nil {
+		t.Fatal(err)
+	}
+	img, err := qr.EncodeToBitmap()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// damage a codeword
+	placements, err := CodewordMap(qr.Version, LevelM)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := placements[0]
+	img.SetBinary(p.X, p.Y, !img.BinaryAt(p.X, p.Y))
+	orig := bytes.Clone(img.Pix)
+
+	got, err := Overlay(img, WithModuleSize(2), WithQuietZone(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(img.Pix, orig) {
+		t.Error("img is modified")
+	}
+
+	n := img.Bounds().Dx()
+	if got.Bounds().Dx() != (n+2)*2 || got.Bounds().Dy() != (n+2)*2 {
+		t.Errorf("unexpected bounds: %v", got.Bounds())
+	}
+	at := func(x, y int) color.NRGBA {
+		return got.NRGBAAt((x+1)*2, (y+1)*2)
+	}
+
+	// the corner of the finder pattern is dark.
+	if c, want := at(0, 0), (overlay.Module{Kind: overlay.KindFunction}).Color(); c != want {
+		t.Errorf("got %v, want %v", c, want)
+	}
+
+	// the damaged module is highlighted in red, and the others are not.
+	red := func(c color.NRGBA) bool {
+		return c.R > c.G && c.G == c.B
+	}
+	if c := at(p.X, p.Y); !red(c) {
+		t.Errorf("got %v, want red", c)
+	}
+	for _, q := range placements[8:] {
+		if c := at(q.X, q.Y); red(c) {
+			t.Errorf("(%d, %d): got %v, want not red", q.X, q.Y, c)
+		}
+	}
+}
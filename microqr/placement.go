@@ -0,0 +1,53 @@
+package microqr
+
+import "image"
+
+// placement returns the positions of the data and error correction modules
+// in the order in which the bit stream is placed,
+// and the indexes of the bits in the codewords that the modules carry.
+//
+// The last data codeword of M1 and M3 symbols is 4 bits long,
+// so the following bits are shifted to the codeword boundary.
+func placement(version Version, level Level) ([]image.Point, []int) {
+	w := 8 + 2*int(version)
+	used := usedList[version]
+	capacity := capacityTable[version][level]
+	n := (w+1)*(w+1) - used.OnesCount()
+	points := make([]image.Point, 0, n)
+	bits := make([]int, 0, n)
+
+	dy := -1
+	x, y := w, w
+	readBits := 0
+	for {
+		if !used.BinaryAt(x, y) {
+			points = append(points, image.Point{x, y})
+			bits = append(bits, readBits)
+			readBits++
+		}
+		x--
+		if x < 0 {
+			break
+		}
+
+		if !used.BinaryAt(x, y) {
+			points = append(points, image.Point{x, y})
+			bits = append(bits, readBits)
+			readBits++
+		}
+		x, y = x+1, y+dy
+		if y < 0 || y > w {
+			dy *= -1
+			x, y = x-2, y+dy
+		}
+		if x < 0 {
+			break
+		}
+		if readBits == capacity.DataBits {
+			for readBits%8 != 0 {
+				readBits++
+			}
+		}
+	}
+	return points, bits
+}
@@ -0,0 +1,53 @@
+package microqr
+
+import (
+	"errors"
+	"image"
+	"math"
+
+	"github.com/shogo82148/go-imaging/srgb"
+	"github.com/shogo82148/qrcode/internal/grading"
+	"github.com/shogo82148/qrcode/internal/sampling"
+)
+
+// DecodeRaster decodes a Micro QR Code in img, which is a clean raster image of an axis-aligned symbol
+// surrounded by the quiet zone, such as an image encoded by [Encode].
+// The module size is not necessarily an integer, and the edges of the modules may be anti-aliased.
+// The module pitch is fitted to the finder pattern in the linear light,
+// and the number of the modules is counted from the bounds of the symbol.
+// The pitch is refined along the timing patterns, where the error is multiplied by the number of the modules.
+// Then the modules are resampled from the image into a bitmap, which is decoded by [DecodeBitmap].
+// If the quiet zone is dark, the colors are inverted and it is reported in [QRCode.Orientation].
+func DecodeRaster(img image.Image, opts ...DecodeOptions) (*QRCode, error) {
+	gimg := grading.NewImage(srgb.DecodeTone(img))
+	inverted := gimg.LightOnDark()
+	if inverted {
+		gimg.Invert()
+	}
+	rect, run, err := gimg.Locate()
+	if err != nil || run.X == 0 || run.Y == 0 {
+		return nil, errors.New("microqr: symbol not found")
+	}
+	s := sampling.New(gimg)
+	sx, sy, ok := s.FinderScales(rect, run)
+	if !ok {
+		return nil, errors.New("microqr: finder pattern not found")
+	}
+	sx.Modules = int(math.Round((float64(rect.Max.X) - sx.Origin) / sx.Pitch))
+	sy.Modules = int(math.Round((float64(rect.Max.Y) - sy.Origin) / sy.Pitch))
+	n := sx.Modules
+	if n != sy.Modules || n < 11 || n > 17 || n%2 == 0 {
+		return nil, errors.New("microqr: invalid symbol size")
+	}
+	// the top row and the left column are the finder pattern, the separator and the timing pattern.
+	timing := func(k int) bool { return k < 7 || (k >= 8 && k%2 == 0) }
+	sx = s.TimingScaleX(sx, sy, 0, func(x, y int) bool { return timing(x) })
+	sy = s.TimingScaleY(sx, sy, 0, func(x, y int) bool { return timing(y) })
+
+	qr, err := DecodeBitmap(s.Resample(sx, sy), opts...)
+	if err != nil {
+		return nil, err
+	}
+	qr.Orientation.Inverted = qr.Orientation.Inverted != inverted
+	return qr, nil
+}
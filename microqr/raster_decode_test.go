@@ -0,0 +1,32 @@
+package microqr
+
+import "testing"
+
+// TestDecodeRaster checks the size of the symbols counted from the bounds.
+// Fitting and resampling the modules are tested in internal/sampling.
+func TestDecodeRaster(t *testing.T) {
+	options := []struct {
+		name string
+		opts []EncodeOptions
+	}{
+		{"module size 2.5", []EncodeOptions{WithModuleSize(2.5)}},
+		{"width 333", []EncodeOptions{WithWidth(333)}},
+		{"inverted", []EncodeOptions{WithWidth(200), WithInverted(true)}},
+	}
+	for _, o := range options {
+		img, err := Encode([]byte("01234567890123456789"), o.opts...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := DecodeRaster(img)
+		if err != nil {
+			t.Fatalf("%s: %v", o.name, err)
+		}
+		if string(got.Segments[0].Data) != "01234567890123456789" {
+			t.Errorf("%s: got %q", o.name, got.Segments[0].Data)
+		}
+		if got.Orientation.Inverted != (o.name == "inverted") {
+			t.Errorf("%s: got inverted %t", o.name, got.Orientation.Inverted)
+		}
+	}
+}
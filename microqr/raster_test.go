@@ -0,0 +1,27 @@
+package microqr
+
+import (
+	"testing"
+)
+
+func TestEncodePaletted(t *testing.T) {
+	qr, err := New([]byte("MICRO QR"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the layout of the modules is tested in internal/raster.
+	img, err := qr.EncodePaletted(WithQuietZone(2), WithWidth(300))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := img.Bounds().Dx(); got != 300 {
+		t.Errorf("unexpected width: got %d, want %d", got, 300)
+	}
+	got, err := DecodeRaster(img)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got.Segments[0].Data) != "MICRO QR" {
+		t.Errorf("unexpected data: %q", got.Segments[0].Data)
+	}
+}
@@ -0,0 +1,104 @@
+package microqr
+
+import (
+	"github.com/shogo82148/go-imaging/bitmap"
+	"github.com/shogo82148/qrcode/internal/bitstream"
+)
+
+// Report is the diagnostics of decoding a symbol.
+type Report struct {
+	Version Version
+	Level   Level
+	Mask    Mask
+
+	// Orientation is the orientation of the symbol.
+	// The other fields are about the symbol in the normal orientation.
+	Orientation Orientation
+
+	// FormatDistance is the Hamming distance between the format information
+	// and the format information of Version, Level and Mask.
+	FormatDistance int
+
+	// Codewords is the codewords read from the symbol, before the error correction.
+	// The last data codewords of M1 and M3 symbols have only 4 bits in the high nibble.
+	Codewords []byte
+
+	// Blocks is the reports of the Reed-Solomon blocks.
+	// Micro QR Code has only one block.
+	Blocks []BlockReport
+
+	// Data is the data codewords after the error correction.
+	Data []byte
+
+	// Terminator is the bit offset of the terminator in Data.
+	// It is -1 if the terminator is not found.
+	Terminator int
+
+	// Padding is the bit offset of the first pad codeword in Data.
+	// It is the number of the data bits if there is no pad codeword.
+	Padding int
+
+	// NonStandardPadding reports whether the bits after the terminator are different from
+	// the zero bits to the byte boundary followed by the pad codewords 11101100 and 00010001.
+	// The last 4-bit data codeword of M1 and M3 symbols must be 0000.
+	// The decoders ignore them, but they may be a sign of the bugs of the encoder,
+	// or of the data hidden in the symbol.
+	NonStandardPadding bool
+
+	// Violations is the rules of the specification that the symbol breaks, in the order of detection.
+	Violations []Violation
+}
+
+// BlockReport is the diagnostics of a Reed-Solomon block.
+type BlockReport struct {
+	// DataCodewords is the number of the data codewords.
+	DataCodewords int
+
+	// ErrorCorrectionCodewords is the number of the error correction codewords.
+	ErrorCorrectionCodewords int
+
+	// Errors is the number of the corrected codewords.
+	// It is -1 if the errors can't be corrected.
+	Errors int
+}
+
+// DecodeBitmapWithReport decodes img in the same way as [DecodeBitmap],
+// and returns the diagnostics report.
+// The report is returned even if decoding fails, and it has the information collected until the failure.
+func DecodeBitmapWithReport(img *bitmap.Image, opts ...DecodeOptions) (*QRCode, *Report, error) {
+	report := &Report{
+		Terminator: -1,
+	}
+	qr, err := decodeBitmap(img, report, newDecodeOptions(opts))
+	return qr, report, err
+}
+
+// checkPadding checks the bits after the terminator at the bit offset terminator of n bits.
+// If the terminator is not found, the bits after the bit offset end of the last segment are checked,
+// and the terminator is missing if there is room for it.
+func (r *Report) checkPadding(terminator, n, end, dataBits int) {
+	r.Terminator = terminator
+	start := min(dataBits, end)
+	if terminator >= 0 {
+		start = min(dataBits, terminator+n)
+	} else if dataBits-end >= n {
+		r.violate(ViolationTerminator)
+	}
+	padding, ok := bitstream.CheckPadding(r.Data, start, dataBits)
+	r.Padding = padding
+	r.NonStandardPadding = !ok
+	if !ok {
+		r.violate(ViolationPadding)
+	}
+}
+
+// countErrors returns the number of the codewords corrected from orig to data.
+func countErrors(orig, data []byte) int {
+	var n int
+	for i := range orig {
+		if orig[i] != data[i] {
+			n++
+		}
+	}
+	return n
+}
@@ -0,0 +1,57 @@
+package microqr
+
+import "testing"
+
+func TestDecodeBitmapWithReport(t *testing.T) {
+	qr, err := New([]byte("123"), WithLevel(LevelL))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if qr.Version != 2 {
+		t.Fatalf("unexpected version: got %d, want %d", qr.Version, 2)
+	}
+	img, err := qr.EncodeToBitmap()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// damage the format information and a codeword.
+	img.SetBinary(8, 1, !img.BinaryAt(8, 1))
+	m, err := CodewordMap(qr.Version, qr.Level)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, p := range m {
+		if p.Codeword == 2 {
+			img.SetBinary(p.X, p.Y, !img.BinaryAt(p.X, p.Y))
+		}
+	}
+
+	got, report, err := DecodeBitmapWithReport(img)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got.Segments[0].Data) != "123" {
+		t.Errorf("unexpected data: %q", got.Segments[0].Data)
+	}
+	if report.Version != 2 || report.Level != LevelL {
+		t.Errorf("unexpected symbol: M%d-%s", report.Version, report.Level)
+	}
+	if report.FormatDistance != 1 {
+		t.Errorf("unexpected format distance: got %d, want %d", report.FormatDistance, 1)
+	}
+	if len(report.Blocks) != 1 || report.Blocks[0].Errors != 1 {
+		t.Errorf("unexpected blocks: %+v", report.Blocks)
+	}
+
+	// mode(1) + length(4) + 3 digits(10)
+	if report.Terminator != 15 {
+		t.Errorf("unexpected terminator: got %d, want %d", report.Terminator, 15)
+	}
+	if report.Padding != 24 {
+		t.Errorf("unexpected padding: got %d, want %d", report.Padding, 24)
+	}
+	if report.NonStandardPadding {
+		t.Error("want standard padding")
+	}
+}
@@ -0,0 +1,147 @@
+package microqr
+
+import (
+	"errors"
+	"image"
+	"strconv"
+)
+
+// Role is a role of a module in the symbol.
+type Role int
+
+const (
+	// RoleQuietZone is the quiet zone around the symbol.
+	RoleQuietZone Role = iota
+
+	// RoleFinder is the finder pattern.
+	RoleFinder
+
+	// RoleSeparator is the separator around the finder pattern.
+	RoleSeparator
+
+	// RoleTiming is the timing pattern.
+	RoleTiming
+
+	// RoleFormat is the format information.
+	RoleFormat
+
+	// RoleData is the data codeword.
+	RoleData
+
+	// RoleErrorCorrection is the error correction codeword.
+	RoleErrorCorrection
+
+	// RoleRemainder is the remainder bit.
+	RoleRemainder
+)
+
+func (r Role) String() string {
+	switch r {
+	case RoleQuietZone:
+		return "quiet zone"
+	case RoleFinder:
+		return "finder"
+	case RoleSeparator:
+		return "separator"
+	case RoleTiming:
+		return "timing"
+	case RoleFormat:
+		return "format"
+	case RoleData:
+		return "data"
+	case RoleErrorCorrection:
+		return "error correction"
+	case RoleRemainder:
+		return "remainder"
+	}
+	return "invalid(" + strconv.Itoa(int(r)) + ")"
+}
+
+// IsFunction reports whether r is a function pattern or a reserved area,
+// i.e. it doesn't carry the encoded data.
+func (r Role) IsFunction() bool {
+	switch r {
+	case RoleFinder, RoleSeparator, RoleTiming, RoleFormat:
+		return true
+	}
+	return false
+}
+
+// RoleMap is a map of the roles of modules.
+type RoleMap struct {
+	// Rect is the bounds of the symbol in modules.
+	Rect image.Rectangle
+
+	// Roles is the roles of modules in row-major order.
+	Roles []Role
+}
+
+// At returns the role of the module (x, y).
+// It returns RoleQuietZone if (x, y) is outside of the symbol.
+func (m *RoleMap) At(x, y int) Role {
+	if !image.Pt(x, y).In(m.Rect) {
+		return RoleQuietZone
+	}
+	return m.Roles[(y-m.Rect.Min.Y)*m.Rect.Dx()+(x-m.Rect.Min.X)]
+}
+
+func (m *RoleMap) set(x, y int, r Role) {
+	m.Roles[(y-m.Rect.Min.Y)*m.Rect.Dx()+(x-m.Rect.Min.X)] = r
+}
+
+// RoleMap returns the roles of the modules in the symbol.
+// The coordinates are the same as the image returned by [QRCode.EncodeToBitmap].
+func (qr *QRCode) RoleMap() (*RoleMap, error) {
+	if qr.Version < 1 || qr.Version > 4 {
+		return nil, errors.New("microqr: invalid version")
+	}
+	if !qr.Level.IsValid() || formatTable[qr.Version][qr.Level] < 0 {
+		return nil, errors.New("microqr: invalid level")
+	}
+	return newRoleMap(qr.Version, qr.Level), nil
+}
+
+func newRoleMap(version Version, level Level) *RoleMap {
+	n := 9 + 2*int(version)
+	m := &RoleMap{
+		Rect:  image.Rect(0, 0, n, n),
+		Roles: make([]Role, n*n),
+	}
+	used := usedList[version]
+
+	for y := 0; y < n; y++ {
+		for x := 0; x < n; x++ {
+			if !used.BinaryAt(x, y) {
+				m.set(x, y, RoleRemainder)
+				continue
+			}
+			var role Role
+			switch {
+			case x < 7 && y < 7:
+				role = RoleFinder
+			case x < 8 && y < 8:
+				role = RoleSeparator
+			case (x == 8 && y >= 1 && y <= 8) || (y == 8 && x >= 1 && x <= 8):
+				role = RoleFormat
+			default:
+				role = RoleTiming
+			}
+			m.set(x, y, role)
+		}
+	}
+
+	// data modules
+	capacity := capacityTable[version][level]
+	points, bits := placement(version, level)
+	for i, pt := range points {
+		role := RoleRemainder
+		switch idx := bits[i] / 8; {
+		case idx < capacity.Data:
+			role = RoleData
+		case idx < capacity.Total:
+			role = RoleErrorCorrection
+		}
+		m.set(pt.X, pt.Y, role)
+	}
+	return m
+}
@@ -0,0 +1,39 @@
+package microqr
+
+import "testing"
+
+func TestRoleMap(t *testing.T) {
+	for version := Version(1); version <= 4; version++ {
+		for level := levelMin; level < levelMax; level++ {
+			if formatTable[version][level] < 0 {
+				continue
+			}
+			m := newRoleMap(version, level)
+			used := usedList[version]
+			capacity := capacityTable[version][level]
+
+			count := map[Role]int{}
+			for y := m.Rect.Min.Y; y < m.Rect.Max.Y; y++ {
+				for x := m.Rect.Min.X; x < m.Rect.Max.X; x++ {
+					role := m.At(x, y)
+					if role.IsFunction() != bool(used.BinaryAt(x, y)) {
+						t.Errorf("M%d-%s: unexpected role at (%d, %d): %s", version, level, x, y, role)
+					}
+					count[role]++
+				}
+			}
+			if got, want := count[RoleData], capacity.DataBits; got != want {
+				t.Errorf("M%d-%s: data modules: got %d, want %d", version, level, got, want)
+			}
+			if got, want := count[RoleErrorCorrection], (capacity.Total-capacity.Data)*8; got != want {
+				t.Errorf("M%d-%s: error correction modules: got %d, want %d", version, level, got, want)
+			}
+			if got, want := count[RoleFinder], 49; got != want {
+				t.Errorf("M%d-%s: finder modules: got %d, want %d", version, level, got, want)
+			}
+			if got, want := count[RoleFormat], 15; got != want {
+				t.Errorf("M%d-%s: format modules: got %d, want %d", version, level, got, want)
+			}
+		}
+	}
+}
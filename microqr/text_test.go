@@ -0,0 +1,25 @@
+package microqr
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestEncodeText(t *testing.T) {
+	qr, err := New([]byte("MICRO QR"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// splitting the lines into the modules is tested in internal/raster.
+	var buf bytes.Buffer
+	if err := qr.EncodeText(&buf, WithTextChars("██", "  ")); err != nil {
+		t.Fatal(err)
+	}
+	got, err := DecodeText(&buf, "██", "  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got.Segments[0].Data) != "MICRO QR" {
+		t.Errorf("unexpected data: %q", got.Segments[0].Data)
+	}
+}
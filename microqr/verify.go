@@ -0,0 +1,256 @@
+package microqr
+
+import (
+	"errors"
+	"image"
+	"math"
+	"strconv"
+
+	internalbitmap "github.com/shogo82148/qrcode/internal/bitmap"
+	"github.com/shogo82148/qrcode/internal/grading"
+	"github.com/shogo82148/qrcode/internal/reedsolomon"
+)
+
+// Grade is a print quality grade of ISO/IEC 15415.
+type Grade int
+
+const (
+	GradeF Grade = iota
+	GradeD
+	GradeC
+	GradeB
+	GradeA
+)
+
+func (g Grade) String() string {
+	switch g {
+	case GradeA:
+		return "A"
+	case GradeB:
+		return "B"
+	case GradeC:
+		return "C"
+	case GradeD:
+		return "D"
+	case GradeF:
+		return "F"
+	}
+	return "invalid(" + strconv.Itoa(int(g)) + ")"
+}
+
+// Parameter is a measured print quality parameter and its grade.
+type Parameter struct {
+	Value float64
+	Grade Grade
+}
+
+// Verification is the print quality grading of a symbol
+// based on ISO/IEC 15415 and ISO/IEC 29158.
+type Verification struct {
+	// QRCode is the decoded symbol. It is nil if decoding fails.
+	QRCode *QRCode
+
+	// Report is the diagnostics of decoding.
+	Report *Report
+
+	// Grade is the overall grade, which is the lowest grade of the parameters.
+	Grade Grade
+
+	// Decode is GradeA if the symbol is decoded, otherwise GradeF.
+	Decode Grade
+
+	// SymbolContrast is the difference between the maximum and the minimum reflectance.
+	SymbolContrast Parameter
+
+	// MinReflectance is the minimum reflectance.
+	// It must be at most the half of the maximum reflectance.
+	MinReflectance Parameter
+
+	// Modulation is the grade of the modulation of the codeword modules,
+	// taking the error correction into account.
+	Modulation Grade
+
+	// ReflectanceMargin is the grade of the reflectance margin of the codeword modules,
+	// taking the error correction into account.
+	ReflectanceMargin Grade
+
+	// FixedPatternDamage is the grade of the damage of the finder pattern,
+	// the separator and the timing patterns.
+	FixedPatternDamage Grade
+
+	// AxialNonuniformity is the difference of the module pitches in the horizontal and the vertical axes,
+	// relative to their average.
+	AxialNonuniformity Parameter
+
+	// GridNonuniformity is the largest deviation of the module centers from the ideal grid in modules.
+	GridNonuniformity Parameter
+
+	// UnusedErrorCorrection is the ratio of the unused error correction capacity.
+	// M1 symbols can't correct any errors, so it is zero if there is an error.
+	UnusedErrorCorrection Parameter
+
+	m *grading.Measurement
+}
+
+// Verify grades the print quality of a symbol in img, which is a captured grayscale image.
+// The symbol must be surrounded by the quiet zone.
+// It may be rotated, mirrored or inverted, and the modules are graded in the normal orientation.
+// The parameters that need the decoded symbol are graded F if decoding fails.
+func Verify(img image.Image) (*Verification, error) {
+	gimg := grading.NewImage(img)
+	rect, runs, err := gimg.Corners()
+	if err != nil {
+		return nil, errors.New("microqr: symbol not found")
+	}
+
+	// the finder pattern is at one of the corners.
+	// try the sizes estimated from them until the symbol is decoded.
+	var v *Verification
+	tried := make(map[Version]bool)
+	for _, run := range runs {
+		if run.X == 0 {
+			continue
+		}
+		n := int(math.Round(float64(rect.Dx()) * 7 / float64(run.X)))
+		version := Version(int(math.Round(float64(n-9) / 2)))
+		if version < 1 || version > 4 || tried[version] {
+			continue
+		}
+		tried[version] = true
+		n = 9 + 2*int(version)
+		m := grading.Measure(gimg, grading.Grid{Rect: rect, Cols: n, Rows: n})
+		qr, report, err := DecodeBitmapWithReport(m.Bitmap())
+		if v == nil || err == nil {
+			v = &Verification{QRCode: qr, Report: report, m: m}
+		}
+		if err == nil {
+			v.Decode = GradeA
+			break
+		}
+	}
+	if v == nil {
+		return nil, errors.New("microqr: invalid symbol size")
+	}
+
+	m := v.m
+	sc := m.SymbolContrast()
+	v.SymbolContrast = Parameter{Value: sc, Grade: Grade(grading.SymbolContrast(sc))}
+	v.MinReflectance = Parameter{Value: m.Rmin, Grade: Grade(grading.MinReflectance(m.Rmin, m.Rmax))}
+	an := m.AxialNonuniformity()
+	v.AxialNonuniformity = Parameter{Value: an, Grade: Grade(grading.AxialNonuniformity(an))}
+	if v.Decode == GradeA {
+		v.grade(m.Orient(v.Report.Orientation.grading()), v.Report)
+	}
+
+	v.Grade = min(
+		v.Decode,
+		v.SymbolContrast.Grade,
+		v.MinReflectance.Grade,
+		v.Modulation,
+		v.ReflectanceMargin,
+		v.FixedPatternDamage,
+		v.AxialNonuniformity.Grade,
+		v.GridNonuniformity.Grade,
+		v.UnusedErrorCorrection.Grade,
+	)
+	return v, nil
+}
+
+// grade grades the parameters that need the decoded symbol.
+// m is the measurement seen in the normal orientation.
+func (v *Verification) grade(m *grading.Oriented, report *Report) {
+	version, level := report.Version, report.Level
+	ideal := report.ideal()
+	roles := newRoleMap(version, level)
+	placements, _ := CodewordMap(version, level)
+	dark := func(x, y int) bool { return bool(ideal.BinaryAt(x, y)) }
+	function := func(x, y int) bool { return roles.At(x, y).IsFunction() }
+
+	maxErrors := []int{capacityTable[version][level].MaxError}
+
+	// modulation and reflectance margin
+	modulation := make([][]int, len(report.Blocks))
+	margin := make([][]int, len(report.Blocks))
+	for i, blk := range report.Blocks {
+		modulation[i] = make([]int, blk.DataCodewords+blk.ErrorCorrectionCodewords)
+		margin[i] = make([]int, blk.DataCodewords+blk.ErrorCorrectionCodewords)
+		for j := range modulation[i] {
+			modulation[i][j] = grading.A
+			margin[i][j] = grading.A
+		}
+	}
+	for _, p := range placements {
+		mod := &modulation[p.Block][p.Index]
+		*mod = min(*mod, grading.Modulation(m.Modulation(p.X, p.Y)))
+		rm := &margin[p.Block][p.Index]
+		*rm = min(*rm, grading.Modulation(m.Margin(p.X, p.Y, dark(p.X, p.Y))))
+	}
+	v.Modulation = Grade(grading.ErrorCorrection(modulation, maxErrors))
+	v.ReflectanceMargin = Grade(grading.ErrorCorrection(margin, maxErrors))
+
+	// fixed pattern damage
+	n := roles.Rect.Dx()
+	var damage [3]int
+	for y := 0; y < n; y++ {
+		for x := 0; x < n; x++ {
+			if m.Dark(x, y) == dark(x, y) {
+				continue
+			}
+			switch roles.At(x, y) {
+			case RoleFinder, RoleSeparator:
+				damage[0]++
+			case RoleTiming:
+				if y == 0 {
+					damage[1]++
+				} else {
+					damage[2]++
+				}
+			}
+		}
+	}
+	fpd := grading.A
+	for _, errors := range damage {
+		fpd = min(fpd, grading.FixedPatternDamage(errors))
+	}
+	v.FixedPatternDamage = Grade(fpd)
+
+	// grid non-uniformity
+	gn := m.GridNonuniformity(dark, function)
+	v.GridNonuniformity = Parameter{Value: gn, Grade: Grade(grading.GridNonuniformity(gn))}
+
+	// unused error correction
+	uec := 1.0
+	for i, blk := range report.Blocks {
+		uec = min(uec, grading.Unused(blk.Errors, maxErrors[i]))
+	}
+	v.UnusedErrorCorrection = Parameter{Value: uec, Grade: Grade(grading.UnusedErrorCorrection(uec))}
+}
+
+// ideal returns the ideal symbol restored from the error corrected codewords.
+func (r *Report) ideal() *internalbitmap.Image {
+	qr := &QRCode{
+		Version: r.Version,
+		Level:   r.Level,
+		Mask:    r.Mask,
+	}
+	img, _ := qr.EncodeToBitmap()
+	ideal := internalbitmap.Import(img)
+	used := usedList[r.Version]
+	ideal.Mask(ideal, used, maskList[r.Mask])
+
+	var blocks [][]byte
+	data := r.Data
+	for _, blk := range r.Blocks {
+		rs := reedsolomon.New(blk.ErrorCorrectionCodewords)
+		rs.Write(data[:blk.DataCodewords])
+		blocks = append(blocks, append(append([]byte(nil), data[:blk.DataCodewords]...), rs.Sum(nil)...))
+		data = data[blk.DataCodewords:]
+	}
+	placements, _ := CodewordMap(r.Version, r.Level)
+	for _, p := range placements {
+		ideal.SetBinary(p.X, p.Y, (blocks[p.Block][p.Index]>>p.Bit)&1 != 0)
+	}
+
+	ideal.Mask(ideal, used, maskList[r.Mask])
+	return ideal
+}
@@ -0,0 +1,50 @@
+package qrcode
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestReadBitmap(t *testing.T) {
+	qr, err := New([]byte("https://github.com/shogo82148/qrcode"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	encoders := map[string]func(*bytes.Buffer) error{
+		"pbm":       func(buf *bytes.Buffer) error { return qr.EncodePBM(buf, WithModuleSize(3)) },
+		"plain pbm": func(buf *bytes.Buffer) error { return qr.EncodePBM(buf, WithPlain(true)) },
+		"pgm":       func(buf *bytes.Buffer) error { return qr.EncodePGM(buf, WithModuleSize(2)) },
+		"plain pgm": func(buf *bytes.Buffer) error { return qr.EncodePGM(buf, WithPlain(true)) },
+	}
+	for name, encode := range encoders {
+		var buf bytes.Buffer
+		if err := encode(&buf); err != nil {
+			t.Fatal(err)
+		}
+		img, err := ReadBitmap(&buf)
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		got, err := DecodeBitmap(img)
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		if string(got.Segments[0].Data) != "https://github.com/shogo82148/qrcode" {
+			t.Errorf("%s: unexpected data: %q", name, got.Segments[0].Data)
+		}
+	}
+}
+
+func TestEncodeXBM(t *testing.T) {
+	qr, err := New([]byte("https://github.com/shogo82148/qrcode"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if err := qr.EncodeXBM(&buf); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.HasPrefix(buf.Bytes(), []byte("#define qrcode_width ")) {
+		t.Errorf("unexpected header: %q", buf.String())
+	}
+}
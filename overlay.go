@@ -0,0 +1,89 @@
+package qrcode
+
+import (
+	"errors"
+	"image"
+	"math"
+
+	"github.com/shogo82148/go-imaging/bitmap"
+	internalbitmap "github.com/shogo82148/qrcode/internal/bitmap"
+	"github.com/shogo82148/qrcode/internal/overlay"
+)
+
+// Overlay decodes img and renders it color-coded by the roles of the modules.
+// The function patterns, the format and version information,
+// and the data and error correction codewords of each Reed-Solomon block have their own colors.
+// The dark modules are drawn in the deep colors, and the light modules in the pale colors.
+// The modules that the decoder had to correct are drawn in red.
+//
+// img is not modified. Its module size is one pixel, as the image returned by [QRCode.EncodeToBitmap].
+// The symbol is rendered in the normal orientation even if img is mirrored or inverted.
+// The size of the modules and the quiet zone are set by [WithModuleSize] and [WithQuietZone].
+// If decoding fails after reading the format information, the modules are not highlighted.
+func Overlay(img *bitmap.Image, opts ...EncodeOptions) (*image.NRGBA, error) {
+	myopts := newEncodeOptions(opts...)
+	observed := internalbitmap.Import(img).Clone()
+	_, report, err := DecodeBitmapWithReport(observed.Clone().Export())
+	if report.Codewords == nil {
+		return nil, err
+	}
+	observed = report.Orientation.normalize(observed)
+	dark := func(x, y int) bool { return bool(observed.BinaryAt(x, y)) }
+	s := report.overlay(dark, err == nil)
+	return s.Render(int(math.Ceil(myopts.ModuleSize)), myopts.QuietZone), nil
+}
+
+// Overlay renders the captured image with the modules tinted in the colors of [Overlay].
+// The sampling points at the centers of the modules are drawn without tinting.
+func (v *Verification) Overlay() (*image.NRGBA, error) {
+	if v.m == nil || v.Report == nil || v.Report.Codewords == nil {
+		return nil, errors.New("qrcode: format information not found")
+	}
+	o := v.Report.Orientation.grading()
+	s := v.Report.overlay(v.m.Orient(o).Dark, v.Decode == GradeA)
+	return s.Blend(v.m, o), nil
+}
+
+// overlay classifies the modules of the symbol.
+// dark reports whether the module is dark in the symbol read by the decoder.
+func (r *Report) overlay(dark func(x, y int) bool, decoded bool) *overlay.Symbol {
+	roles := newRoleMap(r.Version, r.Level)
+	var ideal *internalbitmap.Image
+	if decoded {
+		ideal = r.ideal()
+	}
+
+	n := roles.Rect.Dx()
+	s := overlay.New(n, n)
+	for y := 0; y < n; y++ {
+		for x := 0; x < n; x++ {
+			m := overlay.Module{
+				Dark: dark(x, y),
+			}
+			switch roles.At(x, y) {
+			case RoleFormat, RoleVersion:
+				m.Kind = overlay.KindFormat
+			case RoleData:
+				m.Kind = overlay.KindData
+			case RoleErrorCorrection:
+				m.Kind = overlay.KindErrorCorrection
+			case RoleRemainder:
+				m.Kind = overlay.KindRemainder
+			default:
+				m.Kind = overlay.KindFunction
+			}
+			if ideal != nil && m.Kind != overlay.KindRemainder {
+				m.Corrected = bool(ideal.BinaryAt(x, y)) != m.Dark
+			}
+			s.Set(x, y, m)
+		}
+	}
+
+	placements, _ := CodewordMap(r.Version, r.Level)
+	for _, p := range placements {
+		m := s.At(p.X, p.Y)
+		m.Block = p.Block
+		s.Set(p.X, p.Y, m)
+	}
+	return s
+}
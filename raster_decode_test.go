@@ -0,0 +1,68 @@
+package qrcode
+
+import (
+	"fmt"
+	"image"
+	"testing"
+)
+
+func TestDecodeRaster(t *testing.T) {
+	data := []string{
+		"Hello, world!",
+		"Lorem ipsum dolor sit amet, consectetur adipiscing elit, sed do eiusmod tempor incididunt ut labore et dolore magna aliqua.",
+	}
+	options := []struct {
+		name string
+		opts []EncodeOptions
+	}{
+		{"default", nil},
+		{"module size 3", []EncodeOptions{WithModuleSize(3)}},
+		{"module size 2.5", []EncodeOptions{WithModuleSize(2.5)}},
+		{"width 100", []EncodeOptions{WithWidth(100)}},
+		{"width 150", []EncodeOptions{WithWidth(150)}},
+		{"width 333", []EncodeOptions{WithWidth(333)}},
+		{"inverted", []EncodeOptions{WithWidth(150), WithInverted(true)}},
+		{"version 40", []EncodeOptions{WithVersion(40), WithWidth(411)}},
+	}
+	for i, d := range data {
+		for _, o := range options {
+			t.Run(fmt.Sprintf("%d/%s", i, o.name), func(t *testing.T) {
+				img, err := Encode([]byte(d), o.opts...)
+				if err != nil {
+					t.Fatal(err)
+				}
+				got, err := DecodeRaster(img)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if string(got.Segments[0].Data) != d {
+					t.Errorf("got %q, want %q", got.Segments[0].Data, d)
+				}
+			})
+		}
+	}
+}
+
+func TestDecodeRaster_Inverted(t *testing.T) {
+	img, err := Encode([]byte("Hello, world!"), WithWidth(200), WithInverted(true))
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := DecodeRaster(img)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !got.Orientation.Inverted {
+		t.Error("want inverted")
+	}
+}
+
+func TestDecodeRaster_NotFound(t *testing.T) {
+	img := image.NewGray(image.Rect(0, 0, 100, 100))
+	for i := range img.Pix {
+		img.Pix[i] = 0xff
+	}
+	if _, err := DecodeRaster(img); err == nil {
+		t.Error("want error, got nil")
+	}
+}
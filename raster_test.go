@@ -0,0 +1,132 @@
+package qrcode
+
+import (
+	"bytes"
+	"image/png"
+	"testing"
+)
+
+func TestEncodePaletted(t *testing.T) {
+	qr, err := New([]byte("https://github.com/shogo82148/qrcode"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := qr.EncodeToBitmap()
+	if err != nil {
+		t.Fatal(err)
+	}
+	size := want.Bounds().Size()
+
+	img, err := qr.EncodePaletted(WithQuietZone(4), WithWidth(300))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := img.Bounds().Dx(); got != 300 {
+		t.Errorf("unexpected width: got %d, want %d", got, 300)
+	}
+
+	// whole pixels per module, and the quiet zone is centered.
+	s := 300 / (size.X + 4*2)
+	offset := (300 - size.X*s) / 2
+	for y := 0; y < size.Y; y++ {
+		for x := 0; x < size.X; x++ {
+			for _, d := range [][2]int{{0, 0}, {s - 1, s - 1}} {
+				got := img.ColorIndexAt(offset+x*s+d[0], offset+y*s+d[1]) == 1
+				if got != bool(want.BinaryAt(x, y)) {
+					t.Fatalf("module (%d, %d) mismatch", x, y)
+				}
+			}
+		}
+	}
+
+	gray, err := qr.EncodeGray(WithQuietZone(4), WithWidth(300))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, v := range img.Pix {
+		if (v == 1) != (gray.Pix[i] == 0) {
+			t.Fatalf("pixel %d mismatch", i)
+		}
+	}
+}
+
+func TestEncodePNG(t *testing.T) {
+	qr, err := New([]byte("https://github.com/shogo82148/qrcode"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := qr.EncodePaletted(WithModuleSize(8))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	if err := qr.EncodePNG(&buf, WithModuleSize(8), WithDPI(600)); err != nil {
+		t.Fatal(err)
+	}
+	got, err := png.Decode(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Bounds() != want.Bounds() {
+		t.Fatalf("unexpected bounds: got %v, want %v", got.Bounds(), want.Bounds())
+	}
+	for y := want.Rect.Min.Y; y < want.Rect.Max.Y; y++ {
+		for x := want.Rect.Min.X; x < want.Rect.Max.X; x++ {
+			r, _, _, _ := got.At(x, y).RGBA()
+			if (r == 0) != (want.ColorIndexAt(x, y) == 1) {
+				t.Fatalf("pixel (%d, %d) mismatch", x, y)
+			}
+		}
+	}
+}
+
+func TestEncodePaletted_Print(t *testing.T) {
+	qr, err := New([]byte("https://github.com/shogo82148/qrcode"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := qr.EncodeToBitmap()
+	if err != nil {
+		t.Fatal(err)
+	}
+	n := want.Bounds().Dx()
+
+	// 0.5 mm at 300 dpi is 5.9 dots, and it is snapped to 6 dots.
+	opts := []EncodeOptions{WithDPI(300), WithModuleSizeMM(0.5), WithBarWidthReduction(2)}
+	img, err := qr.EncodePaletted(opts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := img.Bounds().Dx(), (n+8)*6; got != want {
+		t.Errorf("unexpected width: got %d, want %d", got, want)
+	}
+
+	// the dark modules are shrunk at the edges next to light modules.
+	for y := 0; y < n; y++ {
+		for x := 0; x < n; x++ {
+			X, Y := (x+4)*6, (y+4)*6
+			if got := img.ColorIndexAt(X+3, Y+3) == 1; got != bool(want.BinaryAt(x, y)) {
+				t.Fatalf("module (%d, %d) mismatch", x, y)
+			}
+			if want.BinaryAt(x, y) && !want.BinaryAt(x-1, y) && img.ColorIndexAt(X, Y+3) != 0 {
+				t.Fatalf("module (%d, %d) is not reduced", x, y)
+			}
+			if want.BinaryAt(x, y) && !want.BinaryAt(x+1, y) && img.ColorIndexAt(X+5, Y+3) != 0 {
+				t.Fatalf("module (%d, %d) is not reduced", x, y)
+			}
+		}
+	}
+
+	gray, err := qr.Encode(opts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if gray.Bounds() != img.Bounds() {
+		t.Errorf("unexpected bounds: got %v, want %v", gray.Bounds(), img.Bounds())
+	}
+
+	if _, err := qr.EncodePaletted(WithModuleSizeMM(0.5)); err == nil {
+		t.Error("want error, got nil")
+	}
+}
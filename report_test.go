@@ -0,0 +1,137 @@
+package qrcode
+
+import (
+	"testing"
+)
+
+func TestDecodeBitmapWithReport(t *testing.T) {
+	qr, err := New([]byte("HELLO WORLD"), WithLevel(LevelQ), WithVersion(7))
+	if err != nil {
+		t.Fatal(err)
+	}
+	qr.Mask = Mask3
+	img, err := qr.EncodeToBitmap()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// damage a copy of the format information and the version information.
+	w := img.Bounds().Dx() - 1
+	img.SetBinary(8, 0, !img.BinaryAt(8, 0))
+	img.SetBinary(0, w-10, !img.BinaryAt(0, w-10))
+	img.SetBinary(1, w-10, !img.BinaryAt(1, w-10))
+
+	// damage some codewords.
+	m, err := CodewordMap(qr.Version, qr.Level)
+	if err != nil {
+		t.Fatal(err)
+	}
+	damaged := map[int]bool{}
+	for _, p := range m {
+		if p.Block == 1 && (p.Index == 3 || p.Index == 30) {
+			img.SetBinary(p.X, p.Y, !img.BinaryAt(p.X, p.Y))
+			damaged[p.Codeword] = true
+		}
+	}
+
+	got, report, err := DecodeBitmapWithReport(img)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got.Segments[0].Data) != "HELLO WORLD" {
+		t.Errorf("unexpected data: %q", got.Segments[0].Data)
+	}
+
+	if report.Version != 7 || report.Level != LevelQ || report.Mask != Mask3 {
+		t.Errorf("unexpected symbol: %d-%s mask %d", report.Version, report.Level, report.Mask)
+	}
+	if report.FormatDistances != [2]int{1, 0} {
+		t.Errorf("unexpected format distances: got %v, want %v", report.FormatDistances, [2]int{1, 0})
+	}
+	if report.VersionDistances != [2]int{2, 0} {
+		t.Errorf("unexpected version distances: got %v, want %v", report.VersionDistances, [2]int{2, 0})
+	}
+
+	capacity := capacityTable[7][LevelQ]
+	if len(report.Codewords) != capacity.Total {
+		t.Errorf("unexpected codewords: got %d, want %d", len(report.Codewords), capacity.Total)
+	}
+	if len(report.Data) != capacity.Data {
+		t.Errorf("unexpected data codewords: got %d, want %d", len(report.Data), capacity.Data)
+	}
+	if len(report.Blocks) != 6 {
+		t.Fatalf("unexpected blocks: got %d, want %d", len(report.Blocks), 6)
+	}
+	var total int
+	for i, blk := range report.Blocks {
+		want := 0
+		if i == 1 {
+			want = 2
+		}
+		if blk.Errors != want {
+			t.Errorf("block %d: got %d errors, want %d", i, blk.Errors, want)
+		}
+		total += blk.DataCodewords + blk.ErrorCorrectionCodewords
+	}
+	if total != capacity.Total {
+		t.Errorf("unexpected total codewords: got %d, want %d", total, capacity.Total)
+	}
+
+	// mode(4) + length(9) + 11 characters(61)
+	if report.Terminator != 74 {
+		t.Errorf("unexpected terminator: got %d, want %d", report.Terminator, 74)
+	}
+	if report.Padding != 80 {
+		t.Errorf("unexpected padding: got %d, want %d", report.Padding, 80)
+	}
+	if report.NonStandardPadding {
+		t.Error("want standard padding")
+	}
+}
+
+func TestDecodeBitmapWithReport_NonStandardPadding(t *testing.T) {
+	ht, err := NewHalftone([]byte("HELLO WORLD"), testPicture(100))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, report, err := DecodeBitmapWithReport(ht.Bitmap)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !report.NonStandardPadding {
+		t.Error("want non-standard padding")
+	}
+}
+
+func TestDecodeBitmapWithReport_Error(t *testing.T) {
+	qr, err := New([]byte("HELLO"), WithLevel(LevelH))
+	if err != nil {
+		t.Fatal(err)
+	}
+	img, err := qr.EncodeToBitmap()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// damage too many codewords.
+	m, err := CodewordMap(qr.Version, qr.Level)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, p := range m {
+		if p.Index < 12 {
+			img.SetBinary(p.X, p.Y, !img.BinaryAt(p.X, p.Y))
+		}
+	}
+
+	_, report, err := DecodeBitmapWithReport(img)
+	if err == nil {
+		t.Fatal("want error, got nil")
+	}
+	if report.Level != LevelH {
+		t.Errorf("unexpected level: got %s, want %s", report.Level, LevelH)
+	}
+	if len(report.Blocks) != 1 || report.Blocks[0].Errors != -1 {
+		t.Errorf("unexpected blocks: %+v", report.Blocks)
+	}
+}
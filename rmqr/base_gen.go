@@ -1316,3 +1316,13 @@ var usedList = []*bitmap.Image{
 		},
 	},
 }
+
+// alignmentPatternPositions is the x coordinates of the alignment patterns for each width.
+var alignmentPatternPositions = map[int][]int{
+	27:  {},
+	43:  {21},
+	59:  {19, 39},
+	77:  {25, 51},
+	99:  {23, 49, 75},
+	139: {27, 55, 83, 111},
+}
@@ -0,0 +1,59 @@
+package rmqr
+
+import "errors"
+
+// BitPlacement is the position of a bit of a codeword in the symbol.
+type BitPlacement struct {
+	// X and Y are the coordinates of the module.
+	X, Y int
+
+	// Codeword is the index of the codeword in the interleaved stream.
+	Codeword int
+
+	// Block is the index of the Reed-Solomon block.
+	Block int
+
+	// Index is the index of the codeword in the block.
+	// The error correction codewords follow the data codewords.
+	Index int
+
+	// Bit is the bit index in the codeword. 7 is the most significant bit.
+	Bit int
+
+	// Correction reports whether the codeword is an error correction codeword.
+	Correction bool
+}
+
+// CodewordMap returns the placements of the codeword bits
+// in the order in which [QRCode.EncodeToBitmap] places them.
+// The remainder bits are not included.
+// The last bits that don't fit in the symbol are not placed, so they are not included either.
+func CodewordMap(version Version, level Level) ([]BitPlacement, error) {
+	if !version.IsValid() {
+		return nil, errors.New("rmqr: invalid version")
+	}
+	if !level.IsValid() {
+		return nil, errors.New("rmqr: invalid level")
+	}
+
+	codewords := deinterleave(version, level)
+	points := placement(version)
+	ret := make([]BitPlacement, 0, len(codewords)*8)
+	for i, pt := range points {
+		idx := i / 8
+		if idx >= len(codewords) {
+			break
+		}
+		pos := codewords[idx]
+		ret = append(ret, BitPlacement{
+			X:          pt.X,
+			Y:          pt.Y,
+			Codeword:   idx,
+			Block:      pos.block,
+			Index:      pos.index,
+			Bit:        7 - i%8,
+			Correction: pos.correction,
+		})
+	}
+	return ret, nil
+}
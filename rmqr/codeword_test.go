@@ -0,0 +1,64 @@
+package rmqr
+
+import (
+	"testing"
+
+	internalbitmap "github.com/shogo82148/qrcode/internal/bitmap"
+	"github.com/shogo82148/qrcode/internal/bitstream"
+)
+
+func TestCodewordMap(t *testing.T) {
+	for version := minVersion; version < maxVersion; version++ {
+		for _, level := range []Level{LevelM, LevelH} {
+			m, err := CodewordMap(version, level)
+			if err != nil {
+				t.Fatal(err)
+			}
+			capacity := capacityTable[version][level]
+			if want := min(capacity.Total*8, len(placement(version))); len(m) != want {
+				t.Errorf("%s-%s: got %d bits, want %d", version, level, len(m), want)
+				continue
+			}
+			seen := map[[3]int]bool{}
+			for _, p := range m {
+				key := [3]int{p.Block, p.Index, p.Bit}
+				if seen[key] {
+					t.Errorf("%s-%s: duplicated bit: %v", version, level, key)
+				}
+				seen[key] = true
+			}
+		}
+	}
+}
+
+func TestCodewordMap_Encode(t *testing.T) {
+	qr, err := New([]byte("Rectangular Micro QR Code (rMQR)"), WithLevel(LevelH))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bitstream.Buffer
+	if err := qr.encodeToBits(&buf); err != nil {
+		t.Fatal(err)
+	}
+	stream := buf.Bytes()
+
+	img, err := qr.EncodeToBitmap()
+	if err != nil {
+		t.Fatal(err)
+	}
+	binimg := internalbitmap.Import(img)
+	binimg.Mask(binimg, usedList[qr.Version], precomputedMask)
+
+	m, err := CodewordMap(qr.Version, qr.Level)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, p := range m {
+		got := bool(binimg.BinaryAt(p.X, p.Y))
+		want := (stream[p.Codeword]>>p.Bit)&1 != 0
+		if got != want {
+			t.Errorf("module (%d, %d): got %t, want %t", p.X, p.Y, got, want)
+		}
+	}
+}
@@ -13,107 +13,149 @@ import (
 	"github.com/shogo82148/qrcode/internal/reedsolomon"
 )
 
-func DecodeBitmap(img *bitmap.Image) (*QRCode, error) {
+// DecodeBitmap decodes the rMQR Code in img, whose module size is one pixel.
+// img may be modified during decoding.
+// The mirrored, inverted and rotated symbols are also decoded, and they are reported in [QRCode.Orientation].
+// The rules checked on the symbol depend on the decode mode set by [WithDecodeMode].
+func DecodeBitmap(img *bitmap.Image, opts ...DecodeOptions) (*QRCode, error) {
+	var report Report
+	return decodeBitmap(img, &report, newDecodeOptions(opts))
+}
+
+func decodeBitmap(img *bitmap.Image, report *Report, opts decodeOptions) (*QRCode, error) {
 	binimg := internalbitmap.Import(img)
-	bounds := img.Bounds()
-	w := bounds.Dx() - 1
-	h := bounds.Dy() - 1
+	orientation := detectOrientation(binimg)
+	binimg = orientation.normalize(binimg)
+	report.Orientation = orientation
+
+	qr, err := decodeSymbol(binimg, report, opts)
+	if err != nil {
+		return nil, err
+	}
+	qr.Orientation = orientation
+	return qr, nil
+}
+
+// decodeSymbol decodes the symbol in the normal orientation.
+func decodeSymbol(binimg *internalbitmap.Image, report *Report, opts decodeOptions) (*QRCode, error) {
+	bounds := binimg.Rect
 
 	version, level, err := decodeFormat(binimg)
 	if err != nil {
 		return nil, err
 	}
+	if version.Width() != bounds.Dx() || version.Height() != bounds.Dy() {
+		return nil, fmt.Errorf("rmqr: version %s does not match the size %dx%d", version, bounds.Dy(), bounds.Dx())
+	}
+	report.Version = version
+	report.Level = level
+	format := encodedVersion[uint(version)|uint(level)<<5]
+	raw1, raw2 := readFormat(binimg)
+	report.FormatDistances = [2]int{
+		bits.OnesCount(raw1 ^ format ^ formatMask1),
+		bits.OnesCount(raw2 ^ format ^ formatMask2),
+	}
+	if report.FormatDistances != [2]int{} {
+		report.violate(ViolationFormat)
+	}
 	used := usedList[version]
 	binimg.Mask(binimg, used, precomputedMask)
 
 	var buf bitstream.Buffer
-	dy := -1
-	x, y := w-1, h-5
-	for {
-		if !used.BinaryAt(x, y) {
-			if binimg.BinaryAt(x, y) {
-				buf.WriteBit(1)
-			} else {
-				buf.WriteBit(0)
-			}
-		}
-		x--
-		if x < 1 { // +1 is for avoiding time pattern
-			break
-		}
-
-		if !used.BinaryAt(x, y) {
-			if binimg.BinaryAt(x, y) {
-				buf.WriteBit(1)
-			} else {
-				buf.WriteBit(0)
-			}
-		}
-		x, y = x+1, y+dy
-		if y < +1 || y > h-1 { // +1 and -1 are for avoiding time pattern
-			dy *= -1
-			x, y = x-2, y+dy
-		}
-		if x < 1 { // +1 is for avoiding time pattern
-			break
+	for _, pt := range placement(version) {
+		if binimg.BinaryAt(pt.X, pt.Y) {
+			buf.WriteBit(1)
+		} else {
+			buf.WriteBit(0)
 		}
 	}
 
 	// un-interleave
+	capacity := capacityTable[version][level]
+	report.Codewords = append([]byte(nil), buf.Bytes()[:min(capacity.Total, len(buf.Bytes()))]...)
 	blocks := decodeFromBits(version, level, buf.Bytes())
 
 	// error correction
 	var result []byte
-	for _, blk := range blocks {
+	report.Blocks = make([]BlockReport, len(blocks))
+	for i, blk := range blocks {
 		data := append(blk.data, blk.correction...)
-		if err := reedsolomon.Decode(data, 2); err != nil {
+		orig := append([]byte(nil), data...)
+		report.Blocks[i] = BlockReport{
+			DataCodewords:            len(blk.data),
+			ErrorCorrectionCodewords: len(blk.correction),
+			Errors:                   -1,
+		}
+		if err := reedsolomon.Decode(data, len(blk.correction)); err != nil {
 			return nil, err
 		}
+		report.Blocks[i].Errors = countErrors(orig, data)
 		result = append(result, data[:len(blk.data)]...)
 	}
+	report.Data = result[:capacity.Data]
 
 	// decode segments
-	capacity := capacityTable[version][level]
 	stream := bitstream.NewBuffer(result[:capacity.Data])
+	r := newSegmentReader(stream, capacity.Data*8, opts.Mode, report)
 	segments := make([]Segment, 0)
 	bitLength := capacity.BitLength
+	terminator := -1
 LOOP:
-	for {
+	for !r.Truncated {
+		offset := stream.Offset()
+		r.End = offset
 		mode, err := stream.ReadBits(3)
 		if err == io.EOF {
 			break
 		}
 		switch Mode(mode) {
 		case ModeNumeric:
-			seg, err := decodeNumber(bitLength, stream)
+			seg, err := decodeNumber(bitLength, r)
 			if err != nil {
 				return nil, err
 			}
 			segments = append(segments, seg)
 		case ModeAlphanumeric:
-			seg, err := decodeAlphanumeric(bitLength, stream)
+			seg, err := decodeAlphanumeric(bitLength, r)
 			if err != nil {
 				return nil, err
 			}
 			segments = append(segments, seg)
 		case ModeBytes:
-			seg, err := decodeBytes(bitLength, stream)
+			seg, err := decodeBytes(bitLength, r)
 			if err != nil {
 				return nil, err
 			}
 			segments = append(segments, seg)
 		case ModeKanji:
-			seg, err := decodeKanji(bitLength, stream)
+			seg, err := decodeKanji(bitLength, r)
 			if err != nil {
 				return nil, err
 			}
 			segments = append(segments, seg)
 		case ModeTerminated:
+			terminator = offset
 			break LOOP
 		default:
-			return nil, fmt.Errorf("rmqr: unknown mode: %d", mode)
+			if opts.Mode == DecodeModeDefault {
+				return nil, fmt.Errorf("rmqr: unknown mode: %d", mode)
+			}
+			r.UnknownMode = true
+			// the rest of the stream can't be decoded.
+			break LOOP
 		}
 	}
+	if r.Truncated {
+		// the truncated segment takes the rest of the stream.
+		r.End = r.DataBits
+	}
+	if r.UnknownMode {
+		report.violate(ViolationMode)
+	}
+	report.checkPadding(terminator, 3, r.End, capacity.Data*8)
+	if opts.Mode == DecodeModeStrict && len(report.Violations) > 0 {
+		return nil, &ViolationError{Violations: report.Violations}
+	}
 
 	return &QRCode{
 		Version:  version,
@@ -122,45 +164,59 @@ LOOP:
 	}, nil
 }
 
+// formatMask1 and formatMask2 are the masks of the format information
+// around the finder pattern and the sub-finder pattern.
+const (
+	formatMask1 = 0b011111101010110010
+	formatMask2 = 0b100000101001111011
+)
+
 func decodeFormat(img *internalbitmap.Image) (Version, Level, error) {
+	raw1, raw2 := readFormat(img)
+
+	// search version info around finder pattern
+	version, level, ok := decodeFormat0(raw1 ^ formatMask1)
+	if ok {
+		return version, level, nil
+	}
+
+	// search version info around sub-finder pattern
+	version, level, ok = decodeFormat0(raw2 ^ formatMask2)
+	if ok {
+		return version, level, nil
+	}
+
+	return 0, 0, errors.New("rmqr: rMRQ not found")
+}
+
+// readFormat reads the two copies of the format information
+// around the finder pattern and the sub-finder pattern.
+func readFormat(img *internalbitmap.Image) (uint, uint) {
 	bounds := img.Rect
 	w := bounds.Dx() - 1
 	h := bounds.Dy() - 1
 
-	// search version info around finder pattern
-	var rawVersion uint
+	var raw1, raw2 uint
 	for i := 0; i < 18; i++ {
 		if img.BinaryAt(8+i/5, 1+i%5) {
-			rawVersion |= 1 << i
+			raw1 |= 1 << i
 		}
 	}
-	version, level, ok := decodeFormat0(rawVersion ^ 0b011111101010110010)
-	if ok {
-		return version, level, nil
-	}
-
-	// search version info around sub-finder pattern
-	rawVersion = 0
 	for i := 0; i < 15; i++ {
 		if img.BinaryAt(w-7+i/5, h-5+i%5) {
-			rawVersion |= 1 << i
+			raw2 |= 1 << i
 		}
 	}
 	if img.BinaryAt(w-4, h-5) {
-		rawVersion |= 1 << 15
+		raw2 |= 1 << 15
 	}
 	if img.BinaryAt(w-3, h-5) {
-		rawVersion |= 1 << 16
+		raw2 |= 1 << 16
 	}
 	if img.BinaryAt(w-2, h-5) {
-		rawVersion |= 1 << 17
+		raw2 |= 1 << 17
 	}
-	version, level, ok = decodeFormat0(rawVersion ^ 0b100000101001111011)
-	if ok {
-		return version, level, nil
-	}
-
-	return 0, 0, errors.New("rmqr: rMRQ not found")
+	return raw1, raw2
 }
 
 func decodeFormat0(data uint) (Version, Level, bool) {
@@ -218,14 +274,14 @@ func decodeFromBits(version Version, level Level, buf []byte) []block {
 	return blocks
 }
 
-func decodeNumber(bitLength [5]int, buf *bitstream.Buffer) (Segment, error) {
+func decodeNumber(bitLength [5]int, r *bitstream.SegmentReader) (Segment, error) {
 	n := bitLength[ModeNumeric]
-	length, err := buf.ReadBits(n)
+	length, err := r.Length(n, bitstream.NumericBits)
 	if err != nil {
 		return Segment{}, err
 	}
 	data := make([]byte, length)
-	if err := bitstream.DecodeNumeric(buf, data); err != nil {
+	if err := bitstream.DecodeNumeric(r.Buf, data); err != nil {
 		return Segment{}, err
 	}
 
@@ -235,14 +291,14 @@ func decodeNumber(bitLength [5]int, buf *bitstream.Buffer) (Segment, error) {
 	}, nil
 }
 
-func decodeAlphanumeric(bitLength [5]int, buf *bitstream.Buffer) (Segment, error) {
+func decodeAlphanumeric(bitLength [5]int, r *bitstream.SegmentReader) (Segment, error) {
 	n := bitLength[ModeAlphanumeric]
-	length, err := buf.ReadBits(n)
+	length, err := r.Length(n, bitstream.AlphanumericBits)
 	if err != nil {
 		return Segment{}, err
 	}
 	data := make([]byte, length)
-	if err := bitstream.DecodeAlphanumeric(buf, data); err != nil {
+	if err := bitstream.DecodeAlphanumeric(r.Buf, data); err != nil {
 		return Segment{}, err
 	}
 
@@ -252,14 +308,14 @@ func decodeAlphanumeric(bitLength [5]int, buf *bitstream.Buffer) (Segment, error
 	}, nil
 }
 
-func decodeBytes(bitLength [5]int, buf *bitstream.Buffer) (Segment, error) {
+func decodeBytes(bitLength [5]int, r *bitstream.SegmentReader) (Segment, error) {
 	n := bitLength[ModeBytes]
-	length, err := buf.ReadBits(n)
+	length, err := r.Length(n, bitstream.BytesBits)
 	if err != nil {
 		return Segment{}, err
 	}
 	data := make([]byte, length)
-	if err := bitstream.DecodeBytes(buf, data); err != nil {
+	if err := bitstream.DecodeBytes(r.Buf, data); err != nil {
 		return Segment{}, err
 	}
 
@@ -269,13 +325,13 @@ func decodeBytes(bitLength [5]int, buf *bitstream.Buffer) (Segment, error) {
 	}, nil
 }
 
-func decodeKanji(bitLength [5]int, buf *bitstream.Buffer) (Segment, error) {
+func decodeKanji(bitLength [5]int, r *bitstream.SegmentReader) (Segment, error) {
 	n := bitLength[ModeKanji]
-	length, err := buf.ReadBits(n)
+	length, err := r.Length(n, bitstream.KanjiBits)
 	if err != nil {
 		return Segment{}, err
 	}
-	data, err := bitstream.DecodeKanji(buf, int(length))
+	data, err := r.Kanji(length)
 	if err != nil {
 		return Segment{}, err
 	}
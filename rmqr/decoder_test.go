@@ -0,0 +1,90 @@
+package rmqr
+
+import (
+	"image"
+	"image/color"
+	"image/draw"
+	"reflect"
+	"testing"
+
+	"github.com/shogo82148/qrcode/internal/tracking"
+)
+
+func TestDecoder(t *testing.T) {
+	symbols := make(map[string]*image.Gray)
+	for _, data := range []string{"first", "second"} {
+		qr, err := New([]byte(data))
+		if err != nil {
+			t.Fatal(err)
+		}
+		img, err := qr.EncodeToBitmap()
+		if err != nil {
+			t.Fatal(err)
+		}
+		symbols[data] = capture(img, 3, false)
+	}
+
+	// frame returns the frame of the conveyor with the symbols at the points.
+	frame := func(at map[string]image.Point) *image.Gray {
+		img := image.NewGray(image.Rect(0, 0, 480, 80))
+		draw.Draw(img, img.Bounds(), image.NewUniform(color.Gray{Y: 255}), image.Point{}, draw.Src)
+		for data, p := range at {
+			s := symbols[data]
+			draw.Draw(img, s.Bounds().Add(p), s, image.Point{}, draw.Src)
+		}
+		return img
+	}
+
+	// each step is the frames and the symbols decoded in them.
+	type step struct {
+		frames []map[string]image.Point
+		want   []string
+	}
+	var steps []step
+
+	// the first symbol moves on the conveyor, and it is decoded only once.
+	var moving []map[string]image.Point
+	for x := 0; x <= 30; x += 5 {
+		moving = append(moving, map[string]image.Point{"first": {x, 10}})
+	}
+	steps = append(steps, step{moving, []string{"first"}})
+
+	// the second symbol comes into view, and it is found in a search of the whole frame.
+	var entering []map[string]image.Point
+	for i := 0; i < tracking.ScanInterval; i++ {
+		entering = append(entering, map[string]image.Point{"first": {35 + 5*i, 10}, "second": {300, 20}})
+	}
+	steps = append(steps, step{entering, []string{"second"}})
+
+	// the first symbol leaves the view, and it comes back.
+	var leaving []map[string]image.Point
+	for i := 0; i < tracking.LostFrames; i++ {
+		leaving = append(leaving, map[string]image.Point{"second": {300, 20}})
+	}
+	steps = append(steps, step{leaving, nil})
+	var back []map[string]image.Point
+	for i := 0; i < tracking.ScanInterval; i++ {
+		back = append(back, map[string]image.Point{"first": {20, 10}, "second": {300, 20}})
+	}
+	steps = append(steps, step{back, []string{"first"}})
+
+	d := NewDecoder()
+	for i, st := range steps {
+		var got []string
+		for _, at := range st.frames {
+			for _, qr := range d.Decode(frame(at)) {
+				got = append(got, payload(qr.Segments))
+			}
+		}
+		if !reflect.DeepEqual(got, st.want) {
+			t.Errorf("step %d: got %q, want %q", i, got, st.want)
+		}
+	}
+
+	// the decoder forgets the symbols in view.
+	d.Reset()
+	at := map[string]image.Point{"second": {300, 20}}
+	if got := d.Decode(frame(at)); len(got) != 1 {
+		t.Errorf("got %d symbols after Reset, want 1", len(got))
+	}
+}
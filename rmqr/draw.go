@@ -0,0 +1,59 @@
+package rmqr
+
+import (
+	"image"
+	"image/color"
+	"image/draw"
+
+	"github.com/shogo82148/go-imaging/bitmap"
+	"github.com/shogo82148/qrcode/internal/raster"
+)
+
+// Draw draws rMQR Code with the quiet zone into r of dst.
+// The symbol is rotated clockwise by the angle set by [WithRotation],
+// and it is scaled to fit in r keeping the aspect ratio.
+// The other pixels of dst are left unchanged.
+func (qr *QRCode) Draw(dst draw.Image, r image.Rectangle, opts ...EncodeOptions) error {
+	myopts := newEncodeOptions(opts...)
+	binimg, err := qr.EncodeToBitmap()
+	if err != nil {
+		return err
+	}
+	m := raster.Fit(binimg.Bounds().Size(), myopts.QuietZone, r, myopts.Rotation)
+	return drawTransform(dst, binimg, m, myopts)
+}
+
+// DrawTransform draws rMQR Code with the quiet zone onto dst with an affine transform.
+//
+// m maps module coordinates to pixel coordinates of dst,
+// in the same layout as f64.Aff3 of golang.org/x/image/math/f64:
+// a point (x, y) is mapped to (m[0]*x + m[1]*y + m[2], m[3]*x + m[4]*y + m[5]).
+// The top-left corner of the symbol without the quiet zone is the origin of module coordinates,
+// and a module is a unit square.
+//
+// The edges are antialiased, and the symbol is composited over dst.
+// The dark modules are painted with the color set by [WithForeground],
+// and the light modules and the quiet zone are painted with the color set by [WithBackground].
+// The colors are swapped by [WithInverted].
+func (qr *QRCode) DrawTransform(dst draw.Image, m [6]float64, opts ...EncodeOptions) error {
+	myopts := newEncodeOptions(opts...)
+	binimg, err := qr.EncodeToBitmap()
+	if err != nil {
+		return err
+	}
+	return drawTransform(dst, binimg, m, myopts)
+}
+
+func drawTransform(dst draw.Image, binimg *bitmap.Image, m [6]float64, myopts encodeOptions) error {
+	var fg, bg color.Color = color.Black, color.White
+	if myopts.Foreground != nil {
+		fg = myopts.Foreground
+	}
+	if myopts.Background != nil {
+		bg = myopts.Background
+	}
+	if myopts.Inverted {
+		fg, bg = bg, fg
+	}
+	return raster.Draw(dst, binimg, myopts.QuietZone, m, fg, bg)
+}
@@ -0,0 +1,43 @@
+package rmqr
+
+import (
+	"image"
+	"image/color"
+	"testing"
+)
+
+func TestDraw(t *testing.T) {
+	qr, err := New([]byte("Rectangular Micro QR Code (rMQR)"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := qr.EncodeToBitmap()
+	if err != nil {
+		t.Fatal(err)
+	}
+	size := want.Bounds().Size()
+
+	// draw the vertical symbol.
+	dst := image.NewGray(image.Rect(0, 0, 200, 1000))
+	if err := qr.Draw(dst, dst.Bounds(), WithRotation(90)); err != nil {
+		t.Fatal(err)
+	}
+
+	// the top-left module is at the top-right corner after the rotation.
+	s := min(200.0/float64(size.Y+4), 1000.0/float64(size.X+4))
+	cx, cy := 100.0, 500.0
+	for y := 0; y < size.Y; y++ {
+		for x := 0; x < size.X; x++ {
+			u := (float64(x) + 0.5 - float64(size.X)/2) * s
+			v := (float64(y) + 0.5 - float64(size.Y)/2) * s
+			X, Y := int(cx-v), int(cy+u)
+			got := dst.GrayAt(X, Y).Y < 0x80
+			if got != bool(want.BinaryAt(x, y)) {
+				t.Fatalf("module (%d, %d) mismatch", x, y)
+			}
+		}
+	}
+	if got := dst.GrayAt(0, 0); got != (color.Gray{}) {
+		t.Errorf("the pixel outside the symbol is changed: %v", got)
+	}
+}
@@ -86,6 +86,7 @@ func main() {
 
 	genMaskList(&buf)
 	genBaseList(&buf)
+	genAlignmentPatternPositions(&buf)
 
 	out, err := format.Source(buf.Bytes())
 	if err != nil {
@@ -138,6 +139,22 @@ func genBaseList(buf *bytes.Buffer) {
 	fmt.Fprintf(buf, "}\n")
 }
 
+func genAlignmentPatternPositions(buf *bytes.Buffer) {
+	fmt.Fprintf(buf, "\n// alignmentPatternPositions is the x coordinates of the alignment patterns for each width.\n")
+	fmt.Fprintf(buf, "var alignmentPatternPositions = map[int][]int{\n")
+	for _, width := range []int{27, 43, 59, 77, 99, 139} {
+		fmt.Fprintf(buf, "%d: {", width)
+		for i, pos := range alignmentPatternPositions[width] {
+			if i > 0 {
+				fmt.Fprintf(buf, ", ")
+			}
+			fmt.Fprintf(buf, "%d", pos)
+		}
+		fmt.Fprintf(buf, "},\n")
+	}
+	fmt.Fprintf(buf, "}\n")
+}
+
 func newBase(version int) (*bitmap.Image, *bitmap.Image) {
 	w := size[version].w - 1
 	h := size[version].h - 1
@@ -0,0 +1,229 @@
+package rmqr
+
+import (
+	"errors"
+	"image"
+	"math"
+	"sort"
+
+	"github.com/shogo82148/qrcode/internal/grading"
+	"github.com/shogo82148/qrcode/internal/sampling"
+)
+
+// DecodeImage decodes a rMQR Code in img, which is a captured grayscale image of an upright symbol
+// surrounded by the quiet zone.
+// The symbol may be warped, for example printed on a curved bottle or a wrinkled bag.
+// The modules are sampled on the piecewise grid fitted to the finder pattern, the finder sub pattern,
+// the alignment patterns and the timing patterns,
+// and each module is the majority of the pixels sampled in it.
+// If the finder pattern is not found, the colors are inverted and it is searched again.
+// The symbol taller than wide is decoded as a mirrored symbol.
+func DecodeImage(img image.Image, opts ...DecodeOptions) (*QRCode, error) {
+	qr, _, err := decodeGrading(grading.NewImage(img), opts)
+	return qr, err
+}
+
+// decodeGrading decodes the symbol in gimg, and then in the inverted gimg if the finder pattern is not found.
+// It also returns the bounds of the symbol in gimg, which exclude the quiet zone.
+// gimg may be modified during decoding.
+func decodeGrading(gimg *grading.Image, opts []DecodeOptions) (*QRCode, image.Rectangle, error) {
+	qr, bounds, err := decodeImage(gimg, opts)
+	if !errors.Is(err, errFinderNotFound) {
+		return qr, bounds, err
+	}
+
+	// light on dark symbol
+	gimg.Invert()
+	qr, bounds, err = decodeImage(gimg, opts)
+	if err != nil {
+		return nil, image.Rectangle{}, err
+	}
+	qr.Orientation.Inverted = true
+	return qr, bounds, nil
+}
+
+var errFinderNotFound = errors.New("rmqr: finder pattern not found")
+
+// decodeImage decodes the symbol in gimg, and returns it with its bounds fitted by the grid.
+func decodeImage(gimg *grading.Image, opts []DecodeOptions) (*QRCode, image.Rectangle, error) {
+	rect, _, err := gimg.Locate()
+	if err != nil {
+		return nil, image.Rectangle{}, errFinderNotFound
+	}
+	if rect.Dy() > rect.Dx() {
+		// rMQR Codes are wider than tall, so the symbol is mirrored.
+		qr, bounds, err := decodeImage(gimg.Transpose(), opts)
+		if err != nil {
+			return nil, image.Rectangle{}, err
+		}
+		qr.Orientation.Mirrored = true
+		return qr, image.Rect(bounds.Min.Y, bounds.Min.X, bounds.Max.Y, bounds.Max.X), nil
+	}
+	s := sampling.New(gimg)
+
+	// finder pattern at the top left corner
+	r := float64(min(rect.Dx(), rect.Dy())) / 2
+	q, ok := s.Finder(sampling.Point{X: float64(rect.Min.X), Y: float64(rect.Min.Y)}, r, rect.Dy()*rect.Dy())
+	if !ok {
+		return nil, image.Rectangle{}, errFinderNotFound
+	}
+	finder := finderPattern{quad: q, modules: 7}
+
+	// finder sub pattern at the bottom right corner
+	pitch := finder.pitch()
+	size := (pitch.X + pitch.Y) / 2
+	sub, ok := findSubFinder(s, rect, size)
+	if !ok {
+		return nil, image.Rectangle{}, errFinderNotFound
+	}
+
+	// try the versions in the order of the distance from the estimated size.
+	// the height is reliable, but the width is not if the symbol is wrapped around a cylinder.
+	d := sub.center().Sub(finder.center())
+	w := d.X/((finder.pitch().X+sub.pitch().X)/2) + 6
+	h := d.Y/((finder.pitch().Y+sub.pitch().Y)/2) + 6
+	var versions []Version
+	for v := minVersion; v < maxVersion; v++ {
+		if math.Abs(float64(v.Height())-h) <= 2.5 {
+			versions = append(versions, v)
+		}
+	}
+	distance := func(v Version) float64 {
+		return math.Hypot(float64(v.Width())-w, float64(v.Height())-h)
+	}
+	sort.SliceStable(versions, func(i, j int) bool {
+		return distance(versions[i]) < distance(versions[j])
+	})
+
+	err = errors.New("rmqr: symbol not found")
+	for _, v := range versions {
+		g := fitGrid(s, finder, sub, size, v)
+		qr, e := DecodeBitmap(s.Sample(g), opts...)
+		if e == nil {
+			return qr, g.Bounds(), nil
+		}
+		err = e
+	}
+	return nil, image.Rectangle{}, err
+}
+
+// findSubFinder finds the finder sub pattern near the bottom right corner of rect.
+// Its center stone is the small dark blob surrounded by the light ring of 3 x 3 modules,
+// and the returned pattern is the light ring.
+func findSubFinder(s *sampling.Sampler, rect image.Rectangle, size float64) (finderPattern, bool) {
+	expected := sampling.Point{X: float64(rect.Max.X) - 2.5*size, Y: float64(rect.Max.Y) - 2.5*size}
+	step := max(1, int(size/3))
+	var best finderPattern
+	found := false
+	for y := rect.Max.Y - int(7*size); y < rect.Max.Y; y += step {
+		for x := rect.Max.X - int(7*size); x < rect.Max.X; x += step {
+			if !s.Dark(x, y) {
+				continue
+			}
+			stone, b, ok := s.Blob(sampling.Point{X: float64(x) + 0.5, Y: float64(y) + 0.5}, 0, true, int(2*size*size))
+			if !ok || float64(b.Dx()) > 1.5*size || float64(b.Dy()) > 1.5*size {
+				continue
+			}
+			ring, ok := s.Quad(stone, size, false, int(12*size*size))
+			if !ok {
+				continue
+			}
+			if rs := ring.Size(); rs.X > 4*size || rs.Y > 4*size || rs.X < 2*size || rs.Y < 2*size {
+				continue
+			}
+			c := ring.Center()
+			if found && math.Hypot(c.X-expected.X, c.Y-expected.Y) >= math.Hypot(best.center().X-expected.X, best.center().Y-expected.Y) {
+				continue
+			}
+			best = finderPattern{quad: ring, modules: 3}
+			found = true
+		}
+	}
+	return best, found
+}
+
+// finderPattern is the outer corners of a finder pattern found in the image.
+type finderPattern struct {
+	quad sampling.Quad
+
+	// modules is the number of the modules on each side of quad.
+	modules int
+}
+
+func (f finderPattern) center() sampling.Point {
+	return f.quad.Center()
+}
+
+// pitch returns the module pitches in the pattern.
+func (f finderPattern) pitch() sampling.Point {
+	return f.quad.Size().Mul(1 / float64(f.modules))
+}
+
+// at returns the center of the module (x, y) in the pattern.
+// The modules out of the pattern are extrapolated.
+func (f finderPattern) at(x, y int) sampling.Point {
+	n := float64(f.modules)
+	return f.quad.Map((float64(x)+0.5)/n, (float64(y)+0.5)/n)
+}
+
+// fitGrid fits the grid of version to the symbol with the finder pattern at the top left corner
+// and the finder sub pattern at the bottom right corner.
+// size is the module size estimated from the finder pattern.
+func fitGrid(s *sampling.Sampler, finder, sub finderPattern, size float64, version Version) *sampling.Grid {
+	w, h := version.Width(), version.Height()
+	d := sub.center().Sub(finder.center())
+	u := sampling.Point{X: d.X / float64(w-6)}
+	v := sampling.Point{Y: d.Y / float64(h-6)}
+	affine := func(x, y int) sampling.Point {
+		return finder.center().Add(u.Mul(float64(x - 3))).Add(v.Mul(float64(y - 3)))
+	}
+
+	// the lattice is on the edges of the symbol, the edge of the finder pattern,
+	// the alignment patterns and the finder sub pattern.
+	// the rows are on the centers of the alignment patterns.
+	positions := alignmentPatternPositions[w]
+	xs := append([]int{0, 6}, positions...)
+	xs = append(xs, w-3, w-1)
+	ys := []int{1, h - 2}
+	last := len(xs) - 1
+	g := sampling.NewGrid(w, h, xs, ys)
+	g.Fallback = affine
+
+	g.Set(0, 0, finder.at(0, 1))
+	g.Set(1, 0, finder.at(6, 1))
+	if h == 7 {
+		g.Set(0, 1, finder.at(0, 5))
+		g.Set(1, 1, finder.at(6, 5))
+	}
+	// the light ring of the finder sub pattern is from (w-4, h-4) to (w-2, h-2).
+	g.Set(last-1, 1, sub.at(1, 2))
+	g.Set(last, 1, sub.at(3, 2))
+
+	// the light centers of the alignment patterns
+	for i := range positions {
+		for j := range ys {
+			p, ok := g.Predict(i+2, j)
+			if !ok {
+				p = affine(positions[i], ys[j])
+			}
+			c, b, ok := s.Blob(p, 1.5*size, false, int(2.5*size*size))
+			if !ok || float64(b.Dx()) > 2*size || float64(b.Dy()) > 2*size {
+				continue
+			}
+			if math.Hypot(c.X-p.X, c.Y-p.Y) > 1.5*size {
+				continue
+			}
+			g.Set(i+2, j, c)
+		}
+	}
+	g.Fill()
+
+	// timing patterns
+	base := baseList[version]
+	ideal := func(x, y int) bool { return bool(base.BinaryAt(x, y)) }
+	s.TimingX(g, 0, 8, w-4, ideal)
+	if len(positions) > 0 && h > 9 {
+		s.TimingY(g, positions[0], 3, h-4, ideal)
+	}
+	return g
+}
@@ -0,0 +1,88 @@
+package rmqr
+
+import (
+	"image"
+	"image/color"
+	"math"
+	"testing"
+)
+
+// warp returns the image warped by f, which maps the destination coordinates to the source coordinates.
+func warp(src *image.Gray, f func(x, y float64) (float64, float64)) *image.Gray {
+	b := src.Bounds()
+	dst := image.NewGray(b)
+	for y := b.Min.Y; y < b.Max.Y; y++ {
+		for x := b.Min.X; x < b.Max.X; x++ {
+			sx, sy := f(float64(x)+0.5, float64(y)+0.5)
+			ix := min(max(int(math.Floor(sx)), b.Min.X), b.Max.X-1)
+			iy := min(max(int(math.Floor(sy)), b.Min.Y), b.Max.Y-1)
+			dst.SetGray(x, y, src.GrayAt(ix, iy))
+		}
+	}
+	return dst
+}
+
+func TestDecodeImage(t *testing.T) {
+	tests := []string{
+		"Hello",
+		"Hello, world! Hello, world! Hello, world!",
+		"Lorem ipsum dolor sit amet, consectetur adipiscing elit, sed do eiusmod tempor.",
+	}
+	for _, data := range tests {
+		qr, err := New([]byte(data))
+		if err != nil {
+			t.Fatal(err)
+		}
+		img, err := qr.EncodeToBitmap()
+		if err != nil {
+			t.Fatal(err)
+		}
+		capt := capture(img, 6, false)
+		b := capt.Bounds()
+		w, h := float64(b.Dx()), float64(b.Dy())
+
+		warps := []struct {
+			name string
+			f    func(x, y float64) (float64, float64)
+		}{
+			{"flat", func(x, y float64) (float64, float64) {
+				return x, y
+			}},
+			{"cylinder", func(x, y float64) (float64, float64) {
+				// the symbol is wrapped around a cylinder, and viewed from the front.
+				r := w
+				s := min(max((x-w/2)/r, -1), 1)
+				return w/2 + r*math.Asin(s), y
+			}},
+			{"wave", func(x, y float64) (float64, float64) {
+				// the symbol is printed on a wrinkled surface.
+				return x + 3*math.Sin(2*math.Pi*y/h), y + 3*math.Sin(2*math.Pi*x/w)
+			}},
+		}
+		for _, wp := range warps {
+			t.Run(qr.Version.String()+"/"+wp.name, func(t *testing.T) {
+				got, err := DecodeImage(warp(capt, wp.f))
+				if err != nil {
+					t.Fatal(err)
+				}
+				if got.Version != qr.Version {
+					t.Errorf("got version %s, want %s", got.Version, qr.Version)
+				}
+				if string(got.Segments[0].Data) != data {
+					t.Errorf("got %q, want %q", got.Segments[0].Data, data)
+				}
+			})
+		}
+	}
+}
+
+func TestDecodeImage_NotFound(t *testing.T) {
+	img := image.NewGray(image.Rect(0, 0, 100, 100))
+	for i := range img.Pix {
+		img.Pix[i] = 255
+	}
+	img.SetGray(50, 50, color.Gray{})
+	if _, err := DecodeImage(img); err == nil {
+		t.Error("want error, got nil")
+	}
+}
@@ -0,0 +1,23 @@
+package rmqr
+
+import (
+	"testing"
+)
+
+func TestImage(t *testing.T) {
+	qr, err := New([]byte("Rectangular Micro QR Code (rMQR)"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	img, err := qr.Image(WithModuleSize(3.5), WithWidth(150))
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := DecodeRaster(img)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got.Segments[0].Data) != "Rectangular Micro QR Code (rMQR)" {
+		t.Errorf("unexpected data: %q", got.Segments[0].Data)
+	}
+}
@@ -0,0 +1,76 @@
+package rmqr
+
+import (
+	internalbitmap "github.com/shogo82148/qrcode/internal/bitmap"
+	"github.com/shogo82148/qrcode/internal/grading"
+)
+
+// Orientation is the orientation of a symbol detected by the decoders.
+// The zero value is the normal orientation, dark on light, upright and not mirrored.
+type Orientation struct {
+	// Mirrored reports whether the symbol is mirrored,
+	// e.g. read through glass or from the back of a transparent sheet.
+	// The rows and the columns of the mirrored symbol are swapped.
+	Mirrored bool
+
+	// Inverted reports whether the symbol is light on dark.
+	Inverted bool
+
+	// Rotation is the clockwise rotation of the symbol in degrees, 0, 90, 180 or 270.
+	// The mirrored symbol is rotated after mirroring.
+	Rotation int
+}
+
+// normalize returns img in the normal orientation.
+// img is modified.
+func (o Orientation) normalize(img *internalbitmap.Image) *internalbitmap.Image {
+	if o.Inverted {
+		img.Invert()
+	}
+	if o.Rotation != 0 {
+		img = img.Rotate(360 - o.Rotation)
+	}
+	if o.Mirrored {
+		img = img.Transpose()
+	}
+	return img
+}
+
+// grading returns o for the grading of the print quality.
+func (o Orientation) grading() grading.Orientation {
+	return grading.Orientation{Mirrored: o.Mirrored, Inverted: o.Inverted, Rotation: o.Rotation}
+}
+
+// detectOrientation detects the orientation of the symbol in img
+// from the positions of the finder pattern and the finder sub pattern at the opposite corner.
+// The symbol is mirrored if it is taller than wide after the rotation is corrected,
+// because rMQR Codes are wider than tall.
+func detectOrientation(img *internalbitmap.Image) Orientation {
+	w, h := img.Rect.Dx(), img.Rect.Dy()
+	if w < 7 || h < 7 {
+		return Orientation{}
+	}
+
+	// the corners in the clockwise order from the top left.
+	finders := [4][2]int{{0, 0}, {w - 7, 0}, {w - 7, h - 7}, {0, h - 7}}
+	subFinders := [4][2]int{{w - 5, h - 5}, {0, h - 5}, {0, 0}, {w - 5, 0}}
+	var o Orientation
+	best := 7*7 + 5*5 + 1
+	for i := range finders {
+		f, s := finders[i], subFinders[i]
+		m := img.FinderMismatches(f[0], f[1]) + img.SubFinderMismatches(s[0], s[1])
+		if m < best {
+			best = m
+			o = Orientation{Rotation: i * 90}
+		}
+		if 7*7+5*5-m < best {
+			best = 7*7 + 5*5 - m
+			o = Orientation{Inverted: true, Rotation: i * 90}
+		}
+	}
+	if o.Rotation == 90 || o.Rotation == 270 {
+		w, h = h, w
+	}
+	o.Mirrored = h > w
+	return o
+}
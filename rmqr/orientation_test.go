@@ -0,0 +1,147 @@
+package rmqr
+
+import (
+	"bytes"
+	"image"
+	"testing"
+
+	internalbitmap "github.com/shogo82148/qrcode/internal/bitmap"
+)
+
+func TestDecodeBitmap_Orientation(t *testing.T) {
+	tests := []Orientation{
+		{},
+		{Mirrored: true},
+		{Inverted: true},
+		{Mirrored: true, Inverted: true},
+	}
+	for _, want := range tests {
+		for _, data := range []string{"12345", "Hello, world! Hello, world! Hello, world!"} {
+			qr, err := New([]byte(data))
+			if err != nil {
+				t.Fatal(err)
+			}
+			img, err := qr.EncodeToBitmap()
+			if err != nil {
+				t.Fatal(err)
+			}
+			binimg := internalbitmap.Import(img)
+			if want.Inverted {
+				binimg.Invert()
+			}
+			if want.Mirrored {
+				binimg = binimg.Transpose()
+			}
+
+			got, err := DecodeBitmap(binimg.Export())
+			if err != nil {
+				t.Errorf("%+v: %v", want, err)
+				continue
+			}
+			if got.Orientation != want {
+				t.Errorf("got %+v, want %+v", got.Orientation, want)
+			}
+			if string(got.Segments[0].Data) != data {
+				t.Errorf("got %q, want %q", got.Segments[0].Data, data)
+			}
+		}
+	}
+}
+
+func TestDecodeImage_Orientation(t *testing.T) {
+	qr, err := New([]byte("Hello, world!"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	img, err := qr.EncodeGray(WithInverted(true), WithModuleSize(4))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the quiet zone is dark, and the corner of the finder pattern is light.
+	if c := img.GrayAt(0, 0).Y; c != 0x00 {
+		t.Errorf("quiet zone: got %02x, want 00", c)
+	}
+	if c := img.GrayAt(2*4, 2*4).Y; c != 0xff {
+		t.Errorf("finder pattern: got %02x, want ff", c)
+	}
+
+	got, err := DecodeImage(img)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (Orientation{Inverted: true}); got.Orientation != want {
+		t.Errorf("got %+v, want %+v", got.Orientation, want)
+	}
+
+	// mirrored and inverted
+	b := img.Bounds()
+	mirrored := image.NewGray(image.Rect(0, 0, b.Dy(), b.Dx()))
+	for y := 0; y < b.Dy(); y++ {
+		for x := 0; x < b.Dx(); x++ {
+			mirrored.SetGray(y, x, img.GrayAt(x, y))
+		}
+	}
+	got, err = DecodeImage(mirrored)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (Orientation{Mirrored: true, Inverted: true}); got.Orientation != want {
+		t.Errorf("got %+v, want %+v", got.Orientation, want)
+	}
+}
+
+func TestWithInverted(t *testing.T) {
+	qr, err := New([]byte("12345"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if err := qr.EncodeText(&buf, WithInverted(true), WithQuietZone(1)); err != nil {
+		t.Fatal(err)
+	}
+	lines := bytes.Split(buf.Bytes(), []byte("\n"))
+	if len(bytes.Trim(lines[0], "#")) != 0 || !bytes.HasPrefix(lines[1], []byte("# ")) {
+		t.Errorf("unexpected text: %q", buf.String())
+	}
+}
+
+func TestDecodeBitmap_Rotation(t *testing.T) {
+	data := "12345"
+	qr, err := New([]byte(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	img, err := qr.EncodeToBitmap()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, mirrored := range []bool{false, true} {
+		for _, inverted := range []bool{false, true} {
+			for _, rotation := range []int{0, 90, 180, 270} {
+				want := Orientation{Mirrored: mirrored, Inverted: inverted, Rotation: rotation}
+				binimg := internalbitmap.Import(img).Clone()
+				if inverted {
+					binimg.Invert()
+				}
+				if mirrored {
+					binimg = binimg.Transpose()
+				}
+				binimg = binimg.Rotate(rotation)
+
+				got, err := DecodeBitmap(binimg.Export())
+				if err != nil {
+					t.Errorf("%+v: %v", want, err)
+					continue
+				}
+				if got.Orientation != want {
+					t.Errorf("got %+v, want %+v", got.Orientation, want)
+				}
+				if string(got.Segments[0].Data) != data {
+					t.Errorf("got %q, want %q", got.Segments[0].Data, data)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,74 @@
+package rmqr
+
+import "image"
+
+// placement returns the positions of the data and error correction modules
+// in the order in which the bit stream is placed.
+func placement(version Version) []image.Point {
+	used := usedList[version]
+	bounds := used.Rect
+	w, h := bounds.Dx()-1, bounds.Dy()-1
+	ret := make([]image.Point, 0, bounds.Dx()*bounds.Dy()-used.OnesCount())
+
+	dy := -1
+	x, y := w-1, h-5
+	for {
+		if !used.BinaryAt(x, y) {
+			ret = append(ret, image.Point{x, y})
+		}
+		x--
+		if x < 1 {
+			break
+		}
+
+		if !used.BinaryAt(x, y) {
+			ret = append(ret, image.Point{x, y})
+		}
+		x, y = x+1, y+dy
+		if y < 1 || y > h-1 {
+			dy *= -1
+			x, y = x-2, y+dy
+		}
+		if x < 1 {
+			break
+		}
+	}
+	return ret
+}
+
+// codewordPosition is a position of a codeword in the RS blocks.
+type codewordPosition struct {
+	block      int  // index of the block
+	index      int  // index in the block
+	correction bool // whether it is an error correction codeword
+}
+
+// deinterleave returns the positions in the RS blocks of codewords
+// in the order of the interleaved stream.
+func deinterleave(version Version, level Level) []codewordPosition {
+	capacity := capacityTable[version][level]
+	var data, correction []int
+	for _, blockCapacity := range capacity.Blocks {
+		for i := 0; i < blockCapacity.Num; i++ {
+			data = append(data, blockCapacity.Data)
+			correction = append(correction, blockCapacity.Total-blockCapacity.Data)
+		}
+	}
+
+	ret := make([]codewordPosition, 0, capacity.Total)
+	for i := 0; len(ret) < capacity.Data; i++ {
+		for j, n := range data {
+			if i < n {
+				ret = append(ret, codewordPosition{block: j, index: i})
+			}
+		}
+	}
+	for i := 0; len(ret) < capacity.Total; i++ {
+		for j, n := range correction {
+			if i < n {
+				ret = append(ret, codewordPosition{block: j, index: data[j] + i, correction: true})
+			}
+		}
+	}
+	return ret
+}
@@ -0,0 +1,78 @@
+package rmqr
+
+import (
+	"errors"
+	"image"
+	"io"
+	"math"
+
+	"github.com/shogo82148/go-imaging/bitmap"
+	"github.com/shogo82148/qrcode/internal/raster"
+)
+
+// EncodePaletted encodes rMQR Code into a 1-bit paletted image.
+// Unlike [QRCode.Encode], the module size is snapped to whole pixels and no resampling is done.
+// The width of the image is calculated in the same way as [QRCode.Encode],
+// and the quiet zone absorbs the pixels that don't fit whole modules.
+func (qr *QRCode) EncodePaletted(opts ...EncodeOptions) (*image.Paletted, error) {
+	myopts := newEncodeOptions(opts...)
+	binimg, err := qr.EncodeToBitmap()
+	if err != nil {
+		return nil, err
+	}
+	l, err := newLayout(binimg, myopts)
+	if err != nil {
+		return nil, err
+	}
+	return raster.Paletted(binimg, l), nil
+}
+
+// EncodeGray encodes rMQR Code into a gray image.
+// The image is rendered in the same way as [QRCode.EncodePaletted].
+func (qr *QRCode) EncodeGray(opts ...EncodeOptions) (*image.Gray, error) {
+	myopts := newEncodeOptions(opts...)
+	binimg, err := qr.EncodeToBitmap()
+	if err != nil {
+		return nil, err
+	}
+	l, err := newLayout(binimg, myopts)
+	if err != nil {
+		return nil, err
+	}
+	return raster.Gray(binimg, l), nil
+}
+
+// EncodePNG encodes rMQR Code into a 1-bit grayscale PNG image and writes it to w.
+// The image is rendered in the same way as [QRCode.EncodePaletted],
+// but the scanlines are streamed to w, so the memory usage is bounded by one row of the image.
+// The resolution set by [WithDPI] is recorded in the image.
+func (qr *QRCode) EncodePNG(w io.Writer, opts ...EncodeOptions) error {
+	myopts := newEncodeOptions(opts...)
+	binimg, err := qr.EncodeToBitmap()
+	if err != nil {
+		return err
+	}
+	l, err := newLayout(binimg, myopts)
+	if err != nil {
+		return err
+	}
+	return raster.EncodePNG(w, binimg, l, myopts.DPI)
+}
+
+func newLayout(binimg *bitmap.Image, myopts encodeOptions) (raster.Layout, error) {
+	moduleSize := myopts.ModuleSize
+	if myopts.ModuleSizeMM > 0 {
+		if myopts.DPI <= 0 {
+			return raster.Layout{}, errors.New("rmqr: the module size in millimeters requires the DPI")
+		}
+		// snap the module size to whole printer dots.
+		moduleSize = max(1, math.Round(myopts.ModuleSizeMM/25.4*myopts.DPI))
+	}
+	if myopts.BarWidthReduction < 0 {
+		return raster.Layout{}, errors.New("rmqr: negative bar width reduction")
+	}
+	l := raster.NewLayout(binimg.Bounds().Size(), myopts.QuietZone, moduleSize, myopts.Width)
+	l.Reduction = myopts.BarWidthReduction
+	l.Inverted = myopts.Inverted
+	return l, nil
+}
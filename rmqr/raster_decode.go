@@ -0,0 +1,60 @@
+package rmqr
+
+import (
+	"errors"
+	"image"
+	"math"
+
+	"github.com/shogo82148/go-imaging/srgb"
+	"github.com/shogo82148/qrcode/internal/grading"
+	"github.com/shogo82148/qrcode/internal/sampling"
+)
+
+// DecodeRaster decodes a rMQR Code in img, which is a clean raster image of an axis-aligned symbol
+// surrounded by the quiet zone, such as an image encoded by [Encode].
+// The module size is not necessarily an integer, and the edges of the modules may be anti-aliased.
+// The module pitch is fitted to the finder pattern in the linear light,
+// and the numbers of the modules are counted from the bounds of the symbol.
+// The pitches are refined along the timing patterns, where the error is multiplied by the number of the modules.
+// Then the modules are resampled from the image into a bitmap, which is decoded by [DecodeBitmap].
+// If the quiet zone is dark, the colors are inverted and it is reported in [QRCode.Orientation].
+func DecodeRaster(img image.Image, opts ...DecodeOptions) (*QRCode, error) {
+	gimg := grading.NewImage(srgb.DecodeTone(img))
+	inverted := gimg.LightOnDark()
+	if inverted {
+		gimg.Invert()
+	}
+	rect, run, err := gimg.Locate()
+	if err != nil || run.X == 0 || run.Y == 0 {
+		return nil, errors.New("rmqr: symbol not found")
+	}
+	s := sampling.New(gimg)
+	sx, sy, ok := s.FinderScales(rect, run)
+	if !ok {
+		return nil, errors.New("rmqr: finder pattern not found")
+	}
+	sx.Modules = int(math.Round((float64(rect.Max.X) - sx.Origin) / sx.Pitch))
+	sy.Modules = int(math.Round((float64(rect.Max.Y) - sy.Origin) / sy.Pitch))
+	version := minVersion
+	for ; version < maxVersion; version++ {
+		if version.Width() == sx.Modules && version.Height() == sy.Modules {
+			break
+		}
+	}
+	if version == maxVersion {
+		return nil, errors.New("rmqr: invalid symbol size")
+	}
+	base := baseList[version]
+	ideal := func(x, y int) bool { return bool(base.BinaryAt(x, y)) }
+	sx = s.TimingScaleX(sx, sy, 0, ideal)
+	if positions := alignmentPatternPositions[sx.Modules]; len(positions) > 0 && sy.Modules > 9 {
+		sy = s.TimingScaleY(sx, sy, positions[0], ideal)
+	}
+
+	qr, err := DecodeBitmap(s.Resample(sx, sy), opts...)
+	if err != nil {
+		return nil, err
+	}
+	qr.Orientation.Inverted = qr.Orientation.Inverted != inverted
+	return qr, nil
+}
@@ -0,0 +1,27 @@
+package rmqr
+
+import (
+	"testing"
+)
+
+func TestEncodePaletted(t *testing.T) {
+	qr, err := New([]byte("Rectangular Micro QR Code (rMQR)"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the layout of the modules is tested in internal/raster.
+	img, err := qr.EncodePaletted(WithQuietZone(2), WithWidth(300))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := img.Bounds().Dx(); got != 300 {
+		t.Errorf("unexpected width: got %d, want %d", got, 300)
+	}
+	got, err := DecodeRaster(img)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got.Segments[0].Data) != "Rectangular Micro QR Code (rMQR)" {
+		t.Errorf("unexpected data: %q", got.Segments[0].Data)
+	}
+}
@@ -0,0 +1,58 @@
+package rmqr
+
+import "testing"
+
+func TestDecodeBitmapWithReport(t *testing.T) {
+	qr, err := New([]byte("rMQR"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if qr.Version != R7x43 {
+		t.Fatalf("unexpected version: got %s, want %s", qr.Version, R7x43)
+	}
+	img, err := qr.EncodeToBitmap()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// damage the format information around the finder pattern, and a codeword.
+	img.SetBinary(8, 1, !img.BinaryAt(8, 1))
+	img.SetBinary(9, 1, !img.BinaryAt(9, 1))
+	m, err := CodewordMap(qr.Version, qr.Level)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, p := range m {
+		if p.Codeword == 3 {
+			img.SetBinary(p.X, p.Y, !img.BinaryAt(p.X, p.Y))
+		}
+	}
+
+	got, report, err := DecodeBitmapWithReport(img)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got.Segments[0].Data) != "rMQR" {
+		t.Errorf("unexpected data: %q", got.Segments[0].Data)
+	}
+	if report.Version != R7x43 || report.Level != qr.Level {
+		t.Errorf("unexpected symbol: %s-%s", report.Version, report.Level)
+	}
+	if report.FormatDistances != [2]int{2, 0} {
+		t.Errorf("unexpected format distances: got %v, want %v", report.FormatDistances, [2]int{2, 0})
+	}
+	if len(report.Blocks) != 1 || report.Blocks[0].Errors != 1 {
+		t.Errorf("unexpected blocks: %+v", report.Blocks)
+	}
+
+	// mode(3) + length(3) + 4 bytes(32)
+	if report.Terminator != 38 {
+		t.Errorf("unexpected terminator: got %d, want %d", report.Terminator, 38)
+	}
+	if report.Padding != 48 {
+		t.Errorf("unexpected padding: got %d, want %d", report.Padding, 48)
+	}
+	if report.NonStandardPadding {
+		t.Error("want standard padding")
+	}
+}
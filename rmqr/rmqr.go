@@ -13,6 +13,10 @@ type QRCode struct {
 	Version  Version
 	Level    Level
 	Segments []Segment
+
+	// Orientation is the orientation of the decoded symbol.
+	// The encoders ignore it. Use [WithInverted] to render inverted symbols.
+	Orientation Orientation
 }
 
 type Version int
@@ -0,0 +1,182 @@
+package rmqr
+
+import (
+	"errors"
+	"image"
+	"strconv"
+)
+
+// Role is a role of a module in the symbol.
+type Role int
+
+const (
+	// RoleQuietZone is the quiet zone around the symbol.
+	RoleQuietZone Role = iota
+
+	// RoleFinder is the finder pattern.
+	RoleFinder
+
+	// RoleSeparator is the separator next to the finder pattern.
+	RoleSeparator
+
+	// RoleFinderSubPattern is the finder sub pattern at the bottom right corner.
+	RoleFinderSubPattern
+
+	// RoleCornerPattern is the corner finder pattern at the top right and the bottom left corners.
+	RoleCornerPattern
+
+	// RoleTiming is the timing pattern.
+	RoleTiming
+
+	// RoleAlignment is the alignment pattern.
+	RoleAlignment
+
+	// RoleFormat is the format information.
+	RoleFormat
+
+	// RoleData is the data codeword.
+	RoleData
+
+	// RoleErrorCorrection is the error correction codeword.
+	RoleErrorCorrection
+
+	// RoleRemainder is the remainder bit.
+	RoleRemainder
+)
+
+func (r Role) String() string {
+	switch r {
+	case RoleQuietZone:
+		return "quiet zone"
+	case RoleFinder:
+		return "finder"
+	case RoleSeparator:
+		return "separator"
+	case RoleFinderSubPattern:
+		return "finder sub pattern"
+	case RoleCornerPattern:
+		return "corner pattern"
+	case RoleTiming:
+		return "timing"
+	case RoleAlignment:
+		return "alignment"
+	case RoleFormat:
+		return "format"
+	case RoleData:
+		return "data"
+	case RoleErrorCorrection:
+		return "error correction"
+	case RoleRemainder:
+		return "remainder"
+	}
+	return "invalid(" + strconv.Itoa(int(r)) + ")"
+}
+
+// IsFunction reports whether r is a function pattern or a reserved area,
+// i.e. it doesn't carry the encoded data.
+func (r Role) IsFunction() bool {
+	switch r {
+	case RoleFinder, RoleSeparator, RoleFinderSubPattern, RoleCornerPattern, RoleTiming, RoleAlignment, RoleFormat:
+		return true
+	}
+	return false
+}
+
+// RoleMap is a map of the roles of modules.
+type RoleMap struct {
+	// Rect is the bounds of the symbol in modules.
+	Rect image.Rectangle
+
+	// Roles is the roles of modules in row-major order.
+	Roles []Role
+}
+
+// At returns the role of the module (x, y).
+// It returns RoleQuietZone if (x, y) is outside of the symbol.
+func (m *RoleMap) At(x, y int) Role {
+	if !image.Pt(x, y).In(m.Rect) {
+		return RoleQuietZone
+	}
+	return m.Roles[(y-m.Rect.Min.Y)*m.Rect.Dx()+(x-m.Rect.Min.X)]
+}
+
+func (m *RoleMap) set(x, y int, r Role) {
+	m.Roles[(y-m.Rect.Min.Y)*m.Rect.Dx()+(x-m.Rect.Min.X)] = r
+}
+
+// RoleMap returns the roles of the modules in the symbol.
+// The coordinates are the same as the image returned by [QRCode.EncodeToBitmap].
+func (qr *QRCode) RoleMap() (*RoleMap, error) {
+	if !qr.Version.IsValid() {
+		return nil, errors.New("rmqr: invalid version")
+	}
+	if !qr.Level.IsValid() {
+		return nil, errors.New("rmqr: invalid level")
+	}
+	return newRoleMap(qr.Version, qr.Level), nil
+}
+
+func newRoleMap(version Version, level Level) *RoleMap {
+	used := usedList[version]
+	bounds := used.Rect
+	w, h := bounds.Dx()-1, bounds.Dy()-1
+	m := &RoleMap{
+		Rect:  image.Rect(0, 0, bounds.Dx(), bounds.Dy()),
+		Roles: make([]Role, bounds.Dx()*bounds.Dy()),
+	}
+
+	// alignment patterns
+	alignment := make(map[image.Point]bool)
+	for _, pos := range alignmentPatternPositions[w+1] {
+		for y := 0; y <= 2; y++ {
+			for x := -1; x <= 1; x++ {
+				alignment[image.Pt(pos+x, y)] = true
+				alignment[image.Pt(pos+x, h-y)] = true
+			}
+		}
+	}
+
+	for y := 0; y <= h; y++ {
+		for x := 0; x <= w; x++ {
+			if !used.BinaryAt(x, y) {
+				m.set(x, y, RoleRemainder)
+				continue
+			}
+			var role Role
+			switch {
+			case x < 7 && y < 7:
+				role = RoleFinder
+			case x < 8 && y < 8:
+				role = RoleSeparator
+			case x >= w-4 && y >= h-4:
+				role = RoleFinderSubPattern
+			case (x <= 1 && y >= h-1) || (x >= w-1 && y <= 1):
+				role = RoleCornerPattern
+			case alignment[image.Pt(x, y)]:
+				role = RoleAlignment
+			case (x >= 8 && x <= 11 && y >= 1 && y <= 5) ||
+				(x >= w-7 && x <= w-5 && y >= h-5 && y <= h-1) ||
+				(y == h-5 && x >= w-4 && x <= w-2):
+				role = RoleFormat
+			default:
+				role = RoleTiming
+			}
+			m.set(x, y, role)
+		}
+	}
+
+	// data modules
+	codewords := deinterleave(version, level)
+	for i, pt := range placement(version) {
+		role := RoleRemainder
+		if idx := i / 8; idx < len(codewords) {
+			if codewords[idx].correction {
+				role = RoleErrorCorrection
+			} else {
+				role = RoleData
+			}
+		}
+		m.set(pt.X, pt.Y, role)
+	}
+	return m
+}
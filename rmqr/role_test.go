@@ -0,0 +1,43 @@
+package rmqr
+
+import "testing"
+
+func TestRoleMap(t *testing.T) {
+	for version := minVersion; version < maxVersion; version++ {
+		for _, level := range []Level{LevelM, LevelH} {
+			m := newRoleMap(version, level)
+			used := usedList[version]
+			capacity := capacityTable[version][level]
+
+			count := map[Role]int{}
+			for y := m.Rect.Min.Y; y < m.Rect.Max.Y; y++ {
+				for x := m.Rect.Min.X; x < m.Rect.Max.X; x++ {
+					role := m.At(x, y)
+					if role.IsFunction() != bool(used.BinaryAt(x, y)) {
+						t.Errorf("%s-%s: unexpected role at (%d, %d): %s", version, level, x, y, role)
+					}
+					count[role]++
+				}
+			}
+			if got, want := count[RoleData], capacity.Data*8; got != want {
+				t.Errorf("%s-%s: data modules: got %d, want %d", version, level, got, want)
+			}
+			// the encoder doesn't place the bits that overflow the modules.
+			if got, want := count[RoleErrorCorrection], min(capacity.Correction*8, len(placement(version))-capacity.Data*8); got != want {
+				t.Errorf("%s-%s: error correction modules: got %d, want %d", version, level, got, want)
+			}
+			if got, want := count[RoleFinder], 7*min(7, m.Rect.Dy()); got != want {
+				t.Errorf("%s-%s: finder modules: got %d, want %d", version, level, got, want)
+			}
+			if got, want := count[RoleFinderSubPattern], 25; got != want {
+				t.Errorf("%s-%s: finder sub pattern modules: got %d, want %d", version, level, got, want)
+			}
+			if got, want := count[RoleFormat], 36; got != want {
+				t.Errorf("%s-%s: format modules: got %d, want %d", version, level, got, want)
+			}
+			if got := m.At(-1, 0); got != RoleQuietZone {
+				t.Errorf("%s-%s: got %s, want %s", version, level, got, RoleQuietZone)
+			}
+		}
+	}
+}
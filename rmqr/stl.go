@@ -0,0 +1,30 @@
+package rmqr
+
+import (
+	"io"
+
+	"github.com/shogo82148/qrcode/internal/vector"
+)
+
+// EncodeSTL encodes rMQR Code into an STL model for 3D printing, and writes it to w.
+// The dark modules are extruded above a base plate that covers the symbol and the quiet zone.
+// The module size is set by [WithModuleSizeMM], and the default size is 1 mm.
+// The thickness of the base plate and the height of the modules are set by [WithExtrusion],
+// and the engraved variant is enabled by [WithEngraved].
+// The binary format is used unless [WithPlain] is enabled.
+func (qr *QRCode) EncodeSTL(w io.Writer, opts ...EncodeOptions) error {
+	myopts := newEncodeOptions(opts...)
+	binimg, err := qr.EncodeToBitmap()
+	if err != nil {
+		return err
+	}
+	moduleSize := myopts.ModuleSizeMM
+	if moduleSize <= 0 {
+		moduleSize = 1
+	}
+	triangles, err := vector.Extrude(binimg, myopts.QuietZone, moduleSize, myopts.Base, myopts.Height, myopts.Engraved)
+	if err != nil {
+		return err
+	}
+	return vector.EncodeSTL(w, triangles, "rmqr", myopts.Plain)
+}
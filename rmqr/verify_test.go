@@ -0,0 +1,131 @@
+package rmqr
+
+import (
+	"image"
+	"testing"
+
+	"github.com/shogo82148/go-imaging/bitmap"
+	internalbitmap "github.com/shogo82148/qrcode/internal/bitmap"
+	"github.com/shogo82148/qrcode/internal/overlay"
+	"github.com/shogo82148/qrcode/internal/raster"
+)
+
+// capture renders img as a grayscale capture with the module size of moduleSize pixels
+// and the quiet zone of 2 modules.
+func capture(img *bitmap.Image, moduleSize int, inverted bool) *image.Gray {
+	l := raster.NewLayout(img.Bounds().Size(), 2, float64(moduleSize), 0)
+	l.Inverted = inverted
+	return raster.Gray(img, l)
+}
+
+func TestVerify(t *testing.T) {
+	qr, err := New([]byte("HELLO"), WithLevel(LevelH))
+	if err != nil {
+		t.Fatal(err)
+	}
+	img, err := qr.EncodeToBitmap()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	v, err := Verify(capture(img, 8, false))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v.Grade != GradeA {
+		t.Errorf("got %v, want %v: %+v", v.Grade, GradeA, v)
+	}
+	if v.QRCode == nil || string(v.QRCode.Segments[0].Data) != "HELLO" {
+		t.Errorf("unexpected decode result: %+v", v.QRCode)
+	}
+	if v.UnusedErrorCorrection.Value != 1 {
+		t.Errorf("got %v, want %v", v.UnusedErrorCorrection.Value, 1)
+	}
+}
+
+func TestVerify_Damage(t *testing.T) {
+	qr, err := New([]byte("HELLO"), WithLevel(LevelH))
+	if err != nil {
+		t.Fatal(err)
+	}
+	img, err := qr.EncodeToBitmap()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// damage the finder pattern
+	img.SetBinary(1, 1, !img.BinaryAt(1, 1))
+	img.SetBinary(2, 1, !img.BinaryAt(2, 1))
+
+	// damage a codeword
+	placements, err := CodewordMap(qr.Version, LevelH)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := placements[0]
+	img.SetBinary(p.X, p.Y, !img.BinaryAt(p.X, p.Y))
+
+	v, err := Verify(capture(img, 8, false))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v.FixedPatternDamage != GradeC {
+		t.Errorf("got %v, want %v", v.FixedPatternDamage, GradeC)
+	}
+	if v.Decode != GradeA {
+		t.Errorf("got %v, want %v", v.Decode, GradeA)
+	}
+	if v.UnusedErrorCorrection.Value >= 1 {
+		t.Errorf("got %v, want less than 1", v.UnusedErrorCorrection.Value)
+	}
+	if v.ReflectanceMargin == GradeF {
+		t.Errorf("got %v, want better than %v", v.ReflectanceMargin, GradeF)
+	}
+}
+
+func TestVerify_Orientation(t *testing.T) {
+	qr, err := New([]byte("HELLO"), WithLevel(LevelH))
+	if err != nil {
+		t.Fatal(err)
+	}
+	img, err := qr.EncodeToBitmap()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	corrected := overlay.Module{Corrected: true}.Color()
+	for _, want := range []Orientation{
+		{Mirrored: true, Rotation: 90},
+		{Inverted: true, Rotation: 180},
+	} {
+		b := internalbitmap.Import(img)
+		if want.Mirrored {
+			b = b.Transpose()
+		}
+		b = b.Rotate(want.Rotation)
+		v, err := Verify(capture(b.Export(), 8, want.Inverted))
+		if err != nil {
+			t.Fatalf("%+v: %v", want, err)
+		}
+		if v.Report.Orientation != want {
+			t.Errorf("%+v: got orientation %+v", want, v.Report.Orientation)
+		}
+		if v.Grade != GradeA {
+			t.Errorf("%+v: got %v, want %v: %+v", want, v.Grade, GradeA, v)
+		}
+
+		// no modules are corrected in the overlay.
+		o, err := v.Overlay()
+		if err != nil {
+			t.Fatal(err)
+		}
+		size := b.Rect.Size()
+		for y := 0; y < size.Y; y++ {
+			for x := 0; x < size.X; x++ {
+				if o.NRGBAAt((x+2)*8+4, (y+2)*8+4) == corrected {
+					t.Errorf("%+v: module (%d, %d) is corrected", want, x, y)
+				}
+			}
+		}
+	}
+}
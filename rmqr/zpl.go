@@ -0,0 +1,24 @@
+package rmqr
+
+import (
+	"io"
+
+	"github.com/shogo82148/qrcode/internal/raster"
+)
+
+// EncodeZPL encodes rMQR Code into a ZPL label for Zebra label printers, and writes it to w.
+// The printers don't support rMQR Code natively,
+// so the exact module matrix is embedded as a ^GF graphic field.
+// The pixels of the image rendered in the same way as [QRCode.EncodePaletted] are the printer dots.
+func (qr *QRCode) EncodeZPL(w io.Writer, opts ...EncodeOptions) error {
+	myopts := newEncodeOptions(opts...)
+	binimg, err := qr.EncodeToBitmap()
+	if err != nil {
+		return err
+	}
+	l, err := newLayout(binimg, myopts)
+	if err != nil {
+		return err
+	}
+	return raster.EncodeZPL(w, binimg, l)
+}
@@ -0,0 +1,187 @@
+package qrcode
+
+import (
+	"errors"
+	"image"
+	"strconv"
+)
+
+// Role is a role of a module in the symbol.
+type Role int
+
+const (
+	// RoleQuietZone is the quiet zone around the symbol.
+	RoleQuietZone Role = iota
+
+	// RoleFinder is the finder pattern.
+	RoleFinder
+
+	// RoleSeparator is the separator around the finder pattern.
+	RoleSeparator
+
+	// RoleTiming is the timing pattern.
+	RoleTiming
+
+	// RoleAlignment is the alignment pattern.
+	RoleAlignment
+
+	// RoleFormat is the format information.
+	RoleFormat
+
+	// RoleVersion is the version information.
+	RoleVersion
+
+	// RoleDarkModule is the dark module next to the format information.
+	RoleDarkModule
+
+	// RoleData is the data codeword.
+	RoleData
+
+	// RoleErrorCorrection is the error correction codeword.
+	RoleErrorCorrection
+
+	// RoleRemainder is the remainder bit.
+	RoleRemainder
+)
+
+func (r Role) String() string {
+	switch r {
+	case RoleQuietZone:
+		return "quiet zone"
+	case RoleFinder:
+		return "finder"
+	case RoleSeparator:
+		return "separator"
+	case RoleTiming:
+		return "timing"
+	case RoleAlignment:
+		return "alignment"
+	case RoleFormat:
+		return "format"
+	case RoleVersion:
+		return "version"
+	case RoleDarkModule:
+		return "dark module"
+	case RoleData:
+		return "data"
+	case RoleErrorCorrection:
+		return "error correction"
+	case RoleRemainder:
+		return "remainder"
+	}
+	return "invalid(" + strconv.Itoa(int(r)) + ")"
+}
+
+// IsFunction reports whether r is a function pattern or a reserved area,
+// i.e. it doesn't carry the encoded data.
+func (r Role) IsFunction() bool {
+	switch r {
+	case RoleFinder, RoleSeparator, RoleTiming, RoleAlignment, RoleFormat, RoleVersion, RoleDarkModule:
+		return true
+	}
+	return false
+}
+
+// RoleMap is a map of the roles of modules.
+type RoleMap struct {
+	// Rect is the bounds of the symbol in modules.
+	Rect image.Rectangle
+
+	// Roles is the roles of modules in row-major order.
+	Roles []Role
+}
+
+// At returns the role of the module (x, y).
+// It returns RoleQuietZone if (x, y) is outside of the symbol.
+func (m *RoleMap) At(x, y int) Role {
+	if !image.Pt(x, y).In(m.Rect) {
+		return RoleQuietZone
+	}
+	return m.Roles[(y-m.Rect.Min.Y)*m.Rect.Dx()+(x-m.Rect.Min.X)]
+}
+
+func (m *RoleMap) set(x, y int, r Role) {
+	m.Roles[(y-m.Rect.Min.Y)*m.Rect.Dx()+(x-m.Rect.Min.X)] = r
+}
+
+// RoleMap returns the roles of the modules in the symbol.
+// The coordinates are the same as the image returned by [QRCode.EncodeToBitmap].
+func (qr *QRCode) RoleMap() (*RoleMap, error) {
+	if !qr.Version.IsValid() || qr.Version == 0 {
+		return nil, errors.New("qrcode: invalid version")
+	}
+	if !qr.Level.IsValid() {
+		return nil, errors.New("qrcode: invalid level")
+	}
+	return newRoleMap(qr.Version, qr.Level), nil
+}
+
+func newRoleMap(version Version, level Level) *RoleMap {
+	n := 17 + 4*int(version)
+	m := &RoleMap{
+		Rect:  image.Rect(0, 0, n, n),
+		Roles: make([]Role, n*n),
+	}
+	used := usedList[version]
+
+	// alignment patterns
+	alignment := make(map[image.Point]bool)
+	positions := alignmentPatternPositions[version]
+	for j, cy := range positions {
+		for i, cx := range positions {
+			last := len(positions) - 1
+			if (i == 0 && j == 0) || (i == last && j == 0) || (i == 0 && j == last) {
+				// finder pattern
+				continue
+			}
+			for y := cy - 2; y <= cy+2; y++ {
+				for x := cx - 2; x <= cx+2; x++ {
+					alignment[image.Pt(x, y)] = true
+				}
+			}
+		}
+	}
+
+	for y := 0; y < n; y++ {
+		for x := 0; x < n; x++ {
+			if !used.BinaryAt(x, y) {
+				m.set(x, y, RoleRemainder)
+				continue
+			}
+			var role Role
+			switch {
+			case (x < 7 && y < 7) || (x >= n-7 && y < 7) || (x < 7 && y >= n-7):
+				role = RoleFinder
+			case (x < 8 && y < 8) || (x >= n-8 && y < 8) || (x < 8 && y >= n-8):
+				role = RoleSeparator
+			case x == 8 && y == n-8:
+				role = RoleDarkModule
+			case (y == 8 && x != timingPatternOffset && (x <= 8 || x >= n-8)) ||
+				(x == 8 && y != timingPatternOffset && (y <= 8 || y >= n-7)):
+				role = RoleFormat
+			case version >= 7 && ((x < 6 && y >= n-11 && y < n-8) || (y < 6 && x >= n-11 && x < n-8)):
+				role = RoleVersion
+			case alignment[image.Pt(x, y)]:
+				role = RoleAlignment
+			default:
+				role = RoleTiming
+			}
+			m.set(x, y, role)
+		}
+	}
+
+	// data modules
+	codewords := deinterleave(version, level)
+	for i, pt := range placement(version) {
+		role := RoleRemainder
+		if idx := i / 8; idx < len(codewords) {
+			if codewords[idx].correction {
+				role = RoleErrorCorrection
+			} else {
+				role = RoleData
+			}
+		}
+		m.set(pt.X, pt.Y, role)
+	}
+	return m
+}
@@ -0,0 +1,53 @@
+package qrcode
+
+import "testing"
+
+func TestRoleMap(t *testing.T) {
+	for version := Version(1); version <= 40; version++ {
+		for _, level := range []Level{LevelL, LevelM, LevelQ, LevelH} {
+			m := newRoleMap(version, level)
+			used := usedList[version]
+			capacity := capacityTable[version][level]
+
+			count := map[Role]int{}
+			for y := m.Rect.Min.Y; y < m.Rect.Max.Y; y++ {
+				for x := m.Rect.Min.X; x < m.Rect.Max.X; x++ {
+					role := m.At(x, y)
+					if role.IsFunction() != bool(used.BinaryAt(x, y)) {
+						t.Errorf("%d-%s: unexpected role at (%d, %d): %s", version, level, x, y, role)
+					}
+					count[role]++
+				}
+			}
+			if got, want := count[RoleData], capacity.Data*8; got != want {
+				t.Errorf("%d-%s: data modules: got %d, want %d", version, level, got, want)
+			}
+			if got, want := count[RoleErrorCorrection], (capacity.Total-capacity.Data)*8; got != want {
+				t.Errorf("%d-%s: error correction modules: got %d, want %d", version, level, got, want)
+			}
+			if got, want := count[RoleFinder], 3*49; got != want {
+				t.Errorf("%d-%s: finder modules: got %d, want %d", version, level, got, want)
+			}
+			if got, want := count[RoleFormat], 30; got != want {
+				t.Errorf("%d-%s: format modules: got %d, want %d", version, level, got, want)
+			}
+			if got, want := count[RoleDarkModule], 1; got != want {
+				t.Errorf("%d-%s: dark modules: got %d, want %d", version, level, got, want)
+			}
+			wantVersion := 0
+			if version >= 7 {
+				wantVersion = 36
+			}
+			if got := count[RoleVersion]; got != wantVersion {
+				t.Errorf("%d-%s: version modules: got %d, want %d", version, level, got, wantVersion)
+			}
+			wantAlignment := 0
+			if n := len(alignmentPatternPositions[version]); n > 0 {
+				wantAlignment = (n*n - 3) * 25
+			}
+			if got := count[RoleAlignment]; got != wantAlignment {
+				t.Errorf("%d-%s: alignment modules: got %d, want %d", version, level, got, wantAlignment)
+			}
+		}
+	}
+}
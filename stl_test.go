@@ -0,0 +1,42 @@
+package qrcode
+
+import (
+	"bytes"
+	"encoding/binary"
+	"strings"
+	"testing"
+)
+
+func TestEncodeSTL(t *testing.T) {
+	qr, err := New([]byte("https://github.com/shogo82148/qrcode"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	if err := qr.EncodeSTL(&buf, WithModuleSizeMM(2), WithExtrusion(3, 1)); err != nil {
+		t.Fatal(err)
+	}
+	n := binary.LittleEndian.Uint32(buf.Bytes()[80:84])
+	if got, want := buf.Len(), 84+50*int(n); got != want {
+		t.Errorf("unexpected size: got %d, want %d", got, want)
+	}
+
+	// the coplanar faces are merged, and the merged faces and the walls are two triangles each.
+	// a module split into triangles alone would take 12.
+	binimg, err := qr.EncodeToBitmap()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if max := 4 * binimg.Bounds().Dx() * binimg.Bounds().Dy(); int(n) >= max {
+		t.Errorf("too many triangles: %d", n)
+	}
+
+	buf.Reset()
+	if err := qr.EncodeSTL(&buf, WithPlain(true), WithEngraved(true)); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(buf.String(), "solid qrcode\n") {
+		t.Errorf("unexpected header: %q", buf.String()[:20])
+	}
+}
@@ -0,0 +1,285 @@
+package qrcode
+
+import (
+	"image"
+	"image/color"
+	"math"
+
+	"github.com/shogo82148/go-imaging/bitmap"
+	internalbitmap "github.com/shogo82148/qrcode/internal/bitmap"
+	"github.com/shogo82148/qrcode/internal/raster"
+)
+
+// Shape is a shape of data modules.
+type Shape int
+
+const (
+	// ShapeSquare draws each module as a square.
+	ShapeSquare Shape = iota
+
+	// ShapeCircle draws each module as a round dot.
+	ShapeCircle
+
+	// ShapeRoundedSquare draws each module as a square with rounded corners.
+	ShapeRoundedSquare
+
+	// ShapeLiquid connects adjacent dark modules,
+	// and rounds the corners that have no dark neighbors.
+	ShapeLiquid
+)
+
+// FinderShape is a shape of finder patterns.
+type FinderShape int
+
+const (
+	// FinderSquare draws finder patterns as squares.
+	FinderSquare FinderShape = iota
+
+	// FinderRounded draws finder patterns as squares with rounded corners.
+	FinderRounded
+
+	// FinderCircle draws finder patterns as concentric circles.
+	FinderCircle
+)
+
+// WithShape sets the shape of data modules.
+// The default shape is ShapeSquare.
+// Function patterns such as timing and alignment patterns are always drawn as squares.
+func WithShape(shape Shape) EncodeOptions {
+	return func(opts *encodeOptions) {
+		opts.Shape = shape
+	}
+}
+
+// WithFinderShape sets the shape of finder patterns.
+// The default shape is FinderSquare.
+func WithFinderShape(shape FinderShape) EncodeOptions {
+	return func(opts *encodeOptions) {
+		opts.FinderShape = shape
+	}
+}
+
+// WithForeground sets the color of dark modules.
+// The default color is black.
+func WithForeground(c color.Color) EncodeOptions {
+	return func(opts *encodeOptions) {
+		opts.Foreground = c
+	}
+}
+
+// WithBackground sets the color of light modules and the quiet zone.
+// The default color is white.
+func WithBackground(c color.Color) EncodeOptions {
+	return func(opts *encodeOptions) {
+		opts.Background = c
+	}
+}
+
+// WithFinderColor sets the color of finder patterns.
+// The default color is the foreground color.
+func WithFinderColor(c color.Color) EncodeOptions {
+	return func(opts *encodeOptions) {
+		opts.FinderColor = c
+	}
+}
+
+// styled reports whether the options require the styled renderer.
+func (opts *encodeOptions) styled() bool {
+	return opts.Shape != ShapeSquare || opts.FinderShape != FinderSquare ||
+		opts.Foreground != nil || opts.Background != nil || opts.FinderColor != nil
+}
+
+type paint int
+
+const (
+	paintBackground paint = iota
+	paintForeground
+	paintFinder
+)
+
+type styler struct {
+	img    *bitmap.Image
+	used   *internalbitmap.Image
+	n      int // the number of modules in a row
+	shape  Shape
+	finder FinderShape
+}
+
+// paintAt returns the paint of the point (u, v) in module coordinates.
+func (s *styler) paintAt(u, v float64) paint {
+	mx, my := int(math.Floor(u)), int(math.Floor(v))
+	if mx < 0 || my < 0 || mx >= s.n || my >= s.n {
+		return paintBackground
+	}
+
+	// finder patterns
+	for _, origin := range [...]image.Point{{0, 0}, {s.n - 7, 0}, {0, s.n - 7}} {
+		if mx >= origin.X && mx < origin.X+7 && my >= origin.Y && my < origin.Y+7 {
+			if s.eyeAt(u-float64(origin.X)-3.5, v-float64(origin.Y)-3.5) {
+				return paintFinder
+			}
+			return paintBackground
+		}
+	}
+
+	if !s.img.BinaryAt(mx, my) {
+		return paintBackground
+	}
+
+	// the other function patterns stay fully solid.
+	if s.used.BinaryAt(mx, my) {
+		return paintForeground
+	}
+
+	fx, fy := u-float64(mx)-0.5, v-float64(my)-0.5
+	var dark bool
+	switch s.shape {
+	case ShapeCircle:
+		dark = math.Hypot(fx, fy) < 0.5
+	case ShapeRoundedSquare:
+		dark = inRoundedSquare(fx, fy, 0.5, 0.25)
+	case ShapeLiquid:
+		dx, dy := 1, 1
+		if fx < 0 {
+			dx = -1
+		}
+		if fy < 0 {
+			dy = -1
+		}
+		dark = bool(s.img.BinaryAt(mx+dx, my) || s.img.BinaryAt(mx, my+dy)) || math.Hypot(fx, fy) < 0.5
+	default:
+		dark = true
+	}
+	if dark {
+		return paintForeground
+	}
+	return paintBackground
+}
+
+// eyeAt reports whether the point (x, y) relative to the center of a finder pattern is dark.
+func (s *styler) eyeAt(x, y float64) bool {
+	switch s.finder {
+	case FinderCircle:
+		d := math.Hypot(x, y)
+		return d < 1.5 || (d >= 2.5 && d < 3.5)
+	case FinderRounded:
+		if inRoundedSquare(x, y, 1.5, 0.75) {
+			return true
+		}
+		return inRoundedSquare(x, y, 3.5, 1.5) && !inRoundedSquare(x, y, 2.5, 1)
+	default:
+		d := max(math.Abs(x), math.Abs(y))
+		return d < 1.5 || (d >= 2.5 && d < 3.5)
+	}
+}
+
+// inRoundedSquare reports whether the point (x, y) is in the square
+// whose center is the origin, half width is h, and corner radius is r.
+func inRoundedSquare(x, y, h, r float64) bool {
+	x, y = math.Abs(x), math.Abs(y)
+	if x >= h || y >= h {
+		return false
+	}
+	qx, qy := x-(h-r), y-(h-r)
+	if qx <= 0 || qy <= 0 {
+		return true
+	}
+	return math.Hypot(qx, qy) < r
+}
+
+// encodeStyled renders binimg with the styles in myopts.
+// The printing options place the modules on whole printer dots as [raster.Layout] does,
+// and the bar width reduction erodes the dark paint.
+func (qr *QRCode) encodeStyled(binimg *bitmap.Image, myopts encodeOptions) (*image.NRGBA, error) {
+	n := binimg.Bounds().Dx()
+	w := n + myopts.QuietZone*2
+	W := max(
+		int(math.Ceil(float64(w)*myopts.ModuleSize)),
+		myopts.Width,
+	)
+
+	// the pixel (x, y) is on the module ((x-origin)/size, (y-origin)/size).
+	rect := image.Rect(0, 0, W, W)
+	size := float64(W) / float64(w)
+	origin := float64(myopts.QuietZone) * size
+
+	// the dark paint removed from the low and high sides in modules.
+	var low, high float64
+	if myopts.printing() {
+		l, err := newLayout(binimg, myopts)
+		if err != nil {
+			return nil, err
+		}
+		rect = l.Rect
+		size = float64(l.ModuleSize)
+		origin = float64(l.Origin.X)
+		r := min(l.Reduction, l.ModuleSize-1)
+		low, high = float64(r/2)/size, float64(r-r/2)/size
+	}
+
+	s := &styler{
+		img:    binimg,
+		used:   usedList[qr.Version],
+		n:      n,
+		shape:  myopts.Shape,
+		finder: myopts.FinderShape,
+	}
+
+	var fg, bg color.Color = color.Black, color.White
+	if myopts.Foreground != nil {
+		fg = myopts.Foreground
+	}
+	if myopts.Background != nil {
+		bg = myopts.Background
+	}
+	if myopts.Inverted {
+		fg, bg = bg, fg
+	}
+	finder := fg
+	if myopts.FinderColor != nil {
+		finder = myopts.FinderColor
+	}
+	palette := [...]raster.LinearColor{
+		paintBackground: raster.ToLinear(bg),
+		paintForeground: raster.ToLinear(fg),
+		paintFinder:     raster.ToLinear(finder),
+	}
+
+	// the dark paint is the background if the colors are inverted.
+	dark := func(p paint) bool { return (p != paintBackground) != myopts.Inverted }
+	paintAt := func(u, v float64) paint {
+		p := s.paintAt(u, v)
+		if low+high == 0 || !dark(p) {
+			return p
+		}
+		// dot-gain compensation
+		for _, q := range [...]paint{s.paintAt(u-low, v), s.paintAt(u+high, v), s.paintAt(u, v-low), s.paintAt(u, v+high)} {
+			if !dark(q) {
+				return q
+			}
+		}
+		return p
+	}
+
+	// each pixel is super-sampled with ss x ss points.
+	const ss = 4
+	dst := image.NewNRGBA(rect)
+	for y := rect.Min.Y; y < rect.Max.Y; y++ {
+		for x := rect.Min.X; x < rect.Max.X; x++ {
+			var count [len(palette)]int
+			for j := 0; j < ss; j++ {
+				v := (float64(y) + (float64(j)+0.5)/ss - origin) / size
+				for i := 0; i < ss; i++ {
+					u := (float64(x) + (float64(i)+0.5)/ss - origin) / size
+					count[paintAt(u, v)]++
+				}
+			}
+			var sum raster.LinearColor
+			for i, c := range palette {
+				sum = sum.Add(c.Scale(float64(count[i]) / (ss * ss)))
+			}
+			dst.SetNRGBA(x, y, sum.NRGBA())
+		}
+	}
+	return dst, nil
+}
@@ -0,0 +1,96 @@
+package qrcode
+
+import (
+	"image"
+	"image/color"
+	"testing"
+)
+
+func TestEncodeStyled(t *testing.T) {
+	qr, err := New([]byte("https://github.com/shogo82148/qrcode"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := qr.EncodeToBitmap()
+	if err != nil {
+		t.Fatal(err)
+	}
+	n := want.Bounds().Dx()
+
+	shapes := []Shape{ShapeSquare, ShapeCircle, ShapeRoundedSquare, ShapeLiquid}
+	finders := []FinderShape{FinderSquare, FinderRounded, FinderCircle}
+	for _, shape := range shapes {
+		for _, finder := range finders {
+			img, err := qr.Encode(
+				WithModuleSize(8),
+				WithShape(shape),
+				WithFinderShape(finder),
+				WithFinderColor(color.NRGBA{0xff, 0, 0, 0xff}),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, ok := img.(*image.NRGBA); !ok {
+				t.Fatalf("unexpected type: %T", img)
+			}
+			if got, want := img.Bounds().Dx(), (n+8)*8; got != want {
+				t.Errorf("unexpected width: got %d, want %d", got, want)
+			}
+
+			// the center of each module keeps its color,
+			// except finder patterns that may be rounded.
+			for y := 0; y < n; y++ {
+				for x := 0; x < n; x++ {
+					if (x < 7 || x >= n-7) && (y < 7 || y >= n-7) {
+						continue
+					}
+					_, g, _, _ := img.At((x+4)*8+4, (y+4)*8+4).RGBA()
+					got := g < 0x8000
+					if got != bool(want.BinaryAt(x, y)) {
+						t.Errorf("shape %d, finder %d: module (%d, %d) mismatch", shape, finder, x, y)
+					}
+				}
+			}
+
+			// the center of the finder pattern is painted with the finder color.
+			c := color.NRGBAModel.Convert(img.At((3+4)*8+4, (3+4)*8+4)).(color.NRGBA)
+			if c != (color.NRGBA{0xff, 0, 0, 0xff}) {
+				t.Errorf("shape %d, finder %d: unexpected finder color: %v", shape, finder, c)
+			}
+		}
+	}
+}
+
+func TestEncodeStyled_Print(t *testing.T) {
+	qr, err := New([]byte("https://github.com/shogo82148/qrcode"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the square modules in the styled renderer are the same as the printing layout.
+	opts := []EncodeOptions{WithDPI(300), WithModuleSizeMM(0.5), WithBarWidthReduction(2)}
+	want, err := qr.EncodePaletted(opts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	img, err := qr.Encode(append(opts, WithForeground(color.NRGBA{0, 0, 0x80, 0xff}))...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if img.Bounds() != want.Bounds() {
+		t.Fatalf("unexpected bounds: got %v, want %v", img.Bounds(), want.Bounds())
+	}
+	r := img.Bounds()
+	for y := r.Min.Y; y < r.Max.Y; y++ {
+		for x := r.Min.X; x < r.Max.X; x++ {
+			_, g, _, _ := img.At(x, y).RGBA()
+			if got := g < 0x8000; got != (want.ColorIndexAt(x, y) == 1) {
+				t.Fatalf("pixel (%d, %d) mismatch", x, y)
+			}
+		}
+	}
+
+	if _, err := qr.Encode(WithModuleSizeMM(0.5), WithShape(ShapeCircle)); err == nil {
+		t.Error("want error, got nil")
+	}
+}
@@ -1,6 +1,7 @@
 package qrcode
 
 import (
+	"slices"
 	"testing"
 )
 
@@ -36,3 +37,28 @@ func TestTable(t *testing.T) {
 		}
 	}
 }
+
+func TestAlignmentPatternPositions(t *testing.T) {
+	if len(alignmentPatternPositions[1]) != 0 {
+		t.Errorf("version 1: got %v, want no alignment patterns", alignmentPatternPositions[1])
+	}
+	for version := 2; version <= 40; version++ {
+		// ISO/IEC 18004 Annex E: the patterns are spaced evenly from column 6 to column n-7
+		// with an even interval, and the remainder is between the first and the second patterns.
+		// Version 32 is the exception, whose interval is 26 instead of 28.
+		n := 17 + 4*version
+		count := version/7 + 2
+		step := (n - 13 + 2*count - 3) / (2*count - 2) * 2
+		if version == 32 {
+			step = 26
+		}
+		want := make([]int, count)
+		want[0] = 6
+		for i := 1; i < count; i++ {
+			want[i] = n - 7 - (count-1-i)*step
+		}
+		if !slices.Equal(alignmentPatternPositions[version], want) {
+			t.Errorf("version %d: got %v, want %v", version, alignmentPatternPositions[version], want)
+		}
+	}
+}
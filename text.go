@@ -0,0 +1,49 @@
+package qrcode
+
+import (
+	"io"
+
+	"github.com/shogo82148/go-imaging/bitmap"
+	"github.com/shogo82148/qrcode/internal/raster"
+)
+
+// EncodeText encodes QR Code into text and writes it to w.
+// Each module is written as a character, "#" for black and " " for white by default,
+// and the characters can be changed with [WithTextChars].
+// The quiet zone set by [WithQuietZone] is included, and the characters are swapped by [WithInverted].
+// The other options for images are ignored.
+func (qr *QRCode) EncodeText(w io.Writer, opts ...EncodeOptions) error {
+	myopts := newEncodeOptions(opts...)
+	binimg, err := qr.EncodeToBitmap()
+	if err != nil {
+		return err
+	}
+	black, white := myopts.TextBlack, myopts.TextWhite
+	if myopts.Inverted {
+		black, white = white, black
+	}
+	return raster.EncodeText(w, binimg, myopts.QuietZone, black, white)
+}
+
+// ReadText reads text written by [QRCode.EncodeText] from r,
+// and returns the bitmap that [DecodeBitmap] accepts.
+// black and white are the characters of black and white modules passed to [WithTextChars].
+// The quiet zone is removed.
+// The text written with [WithInverted] is read as an inverted symbol, which needs the quiet zone.
+func ReadText(r io.Reader, black, white string) (*bitmap.Image, error) {
+	img, err := raster.DecodeText(r, black, white)
+	if err != nil {
+		return nil, err
+	}
+	return raster.Trim(img)
+}
+
+// DecodeText reads text written by [QRCode.EncodeText] from r, and decodes it.
+// black and white are the characters of black and white modules passed to [WithTextChars].
+func DecodeText(r io.Reader, black, white string) (*QRCode, error) {
+	img, err := ReadText(r, black, white)
+	if err != nil {
+		return nil, err
+	}
+	return DecodeBitmap(img)
+}
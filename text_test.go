@@ -0,0 +1,63 @@
+package qrcode
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestEncodeText(t *testing.T) {
+	qr, err := New([]byte("https://github.com/shogo82148/qrcode"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		black, white string
+	}{
+		{"#", " "},
+		{"██", "  "},
+		{"X", "."},
+	}
+	for _, tt := range tests {
+		var buf bytes.Buffer
+		if err := qr.EncodeText(&buf, WithTextChars(tt.black, tt.white)); err != nil {
+			t.Fatal(err)
+		}
+
+		// editors may remove the trailing spaces.
+		lines := strings.Split(buf.String(), "\n")
+		for i, line := range lines {
+			lines[i] = strings.TrimRight(line, " ")
+		}
+
+		got, err := DecodeText(strings.NewReader(strings.Join(lines, "\n")), tt.black, tt.white)
+		if err != nil {
+			t.Fatalf("%q: %v", tt.black, err)
+		}
+		if string(got.Segments[0].Data) != "https://github.com/shogo82148/qrcode" {
+			t.Errorf("%q: unexpected data: %q", tt.black, got.Segments[0].Data)
+		}
+	}
+}
+
+func TestEncodeText_Inverted(t *testing.T) {
+	qr, err := New([]byte("https://github.com/shogo82148/qrcode"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if err := qr.EncodeText(&buf, WithInverted(true)); err != nil {
+		t.Fatal(err)
+	}
+	got, err := DecodeText(&buf, "#", " ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !got.Orientation.Inverted {
+		t.Error("the symbol is not inverted")
+	}
+	if string(got.Segments[0].Data) != "https://github.com/shogo82148/qrcode" {
+		t.Errorf("unexpected data: %q", got.Segments[0].Data)
+	}
+}
@@ -0,0 +1,115 @@
+package qrcode
+
+import (
+	"slices"
+
+	"github.com/shogo82148/qrcode/internal/bitstream"
+)
+
+// DecodeMode is how strictly the decoders check the symbols against the rules of the specification.
+type DecodeMode int
+
+const (
+	// DecodeModeDefault accepts the symbols that break the rules as long as the data can be decoded.
+	// The broken rules are recorded in [Report.Violations].
+	DecodeModeDefault = DecodeMode(bitstream.DecodeModeDefault)
+
+	// DecodeModeStrict rejects the symbols that break any rule with [*ViolationError].
+	DecodeModeStrict = DecodeMode(bitstream.DecodeModeStrict)
+
+	// DecodeModeLenient recovers as much data as possible from the broken data bit stream.
+	// The segments overflowing the data bits are truncated to the characters that fit,
+	// an unknown mode indicator ends the stream as if it were the terminator,
+	// and the invalid Kanji codes are decoded as U+FFFD REPLACEMENT CHARACTER.
+	DecodeModeLenient = DecodeMode(bitstream.DecodeModeLenient)
+)
+
+// DecodeOptions is the options for decoding.
+type DecodeOptions func(opts *decodeOptions)
+
+type decodeOptions struct {
+	Mode DecodeMode
+}
+
+func newDecodeOptions(opts []DecodeOptions) decodeOptions {
+	var o decodeOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+// WithDecodeMode sets the decode mode.
+// The default mode is DecodeModeDefault.
+func WithDecodeMode(mode DecodeMode) DecodeOptions {
+	return func(opts *decodeOptions) {
+		opts.Mode = mode
+	}
+}
+
+// Violation is a rule of the specification that a symbol breaks.
+type Violation int
+
+const (
+	// ViolationFormat is that a copy of the format information or the version information
+	// is different from the decoded one.
+	ViolationFormat = Violation(bitstream.ViolationFormat)
+
+	// ViolationCharacterCount is that a character count indicator counts more characters
+	// than the rest of the data bits.
+	ViolationCharacterCount = Violation(bitstream.ViolationCharacterCount)
+
+	// ViolationKanji is that a Kanji code is out of range or not assigned to any character.
+	// Such a code is decoded as U+FFFD REPLACEMENT CHARACTER in [DecodeModeLenient].
+	// [DecodeModeDefault] fails to decode the codes out of range without recording it.
+	ViolationKanji = Violation(bitstream.ViolationKanji)
+
+	// ViolationTerminator is that the data bit stream doesn't end with the terminator
+	// even though there is room for it.
+	ViolationTerminator = Violation(bitstream.ViolationTerminator)
+
+	// ViolationPadding is that the bits after the terminator are not the standard padding.
+	// See [Report.NonStandardPadding].
+	ViolationPadding = Violation(bitstream.ViolationPadding)
+
+	// ViolationMode is that a mode indicator is unknown.
+	// [DecodeModeLenient] ends the data bit stream at the unknown mode indicator.
+	ViolationMode = Violation(bitstream.ViolationMode)
+)
+
+func (v Violation) String() string {
+	return bitstream.Violation(v).String()
+}
+
+// ViolationError is the error that a symbol breaks the rules in [DecodeModeStrict].
+type ViolationError struct {
+	Violations []Violation
+}
+
+func (e *ViolationError) Error() string {
+	return "qrcode: the symbol breaks the rules: " + bitstream.JoinViolations(e.Violations)
+}
+
+// violate records the violation v.
+func (r *Report) violate(v Violation) {
+	if !slices.Contains(r.Violations, v) {
+		r.Violations = append(r.Violations, v)
+	}
+}
+
+// newSegmentReader returns a reader of the segments from buf
+// that records the violations in report.
+func newSegmentReader(buf *bitstream.Buffer, dataBits int, mode DecodeMode, report *Report) *bitstream.SegmentReader {
+	return &bitstream.SegmentReader{
+		Buf:      buf,
+		DataBits: dataBits,
+		Mode:     bitstream.DecodeMode(mode),
+		Violate: func(v bitstream.Violation) error {
+			report.violate(Violation(v))
+			if mode == DecodeModeStrict {
+				return &ViolationError{Violations: report.Violations}
+			}
+			return nil
+		},
+	}
+}
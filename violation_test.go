@@ -0,0 +1,216 @@
+package qrcode
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/shogo82148/go-imaging/bitmap"
+	"github.com/shogo82148/qrcode/internal/bitstream"
+	"github.com/shogo82148/qrcode/internal/reedsolomon"
+)
+
+// encodeData returns the 1-L symbol whose data codewords are the bits written by write,
+// followed by the zero bits.
+func encodeData(t *testing.T, write func(buf *bitstream.Buffer)) *bitmap.Image {
+	t.Helper()
+	qr, err := New([]byte("HELLO"), WithLevel(LevelL), WithVersion(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	img, err := qr.EncodeToBitmap()
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, report, err := DecodeBitmapWithReport(img)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// decoding modifies img, so encode it again.
+	img, err = qr.EncodeToBitmap()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	capacity := capacityTable[1][LevelL]
+	var buf bitstream.Buffer
+	write(&buf)
+	for buf.Len() < capacity.Data*8 {
+		buf.WriteBit(0)
+	}
+	data := buf.Bytes()[:capacity.Data]
+	rs := reedsolomon.New(capacity.Total - capacity.Data)
+	rs.Write(data)
+	codewords := append(append([]byte(nil), data...), rs.Sum(nil)...)
+
+	// overwrite the codewords, keeping the mask.
+	m, err := CodewordMap(1, LevelL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, p := range m {
+		if (report.Codewords[p.Codeword]^codewords[p.Codeword])>>p.Bit&1 != 0 {
+			img.SetBinary(p.X, p.Y, !img.BinaryAt(p.X, p.Y))
+		}
+	}
+	return img
+}
+
+// writePadding writes the zero bits to the byte boundary and the pad codewords up to the 1-L capacity.
+func writePadding(buf *bitstream.Buffer) {
+	for buf.Len()%8 != 0 {
+		buf.WriteBit(0)
+	}
+	for i := 0; buf.Len() < capacityTable[1][LevelL].Data*8; i++ {
+		buf.WriteBitsLSB(uint64([]byte{0b1110_1100, 0b0001_0001}[i%2]), 8)
+	}
+}
+
+func writeBytes(buf *bitstream.Buffer, data string) {
+	buf.WriteBitsLSB(uint64(ModeBytes), 4)
+	buf.WriteBitsLSB(uint64(len(data)), 8)
+	for _, b := range []byte(data) {
+		buf.WriteBitsLSB(uint64(b), 8)
+	}
+}
+
+func TestDecodeBitmap_DecodeMode(t *testing.T) {
+	tests := []struct {
+		name   string
+		write  func(buf *bitstream.Buffer)
+		damage bool
+
+		// the rules broken by the symbol.
+		violations []Violation
+
+		// the decoded data in each mode. the missing mode fails.
+		want map[DecodeMode]string
+	}{
+		{
+			name: "standard",
+			write: func(buf *bitstream.Buffer) {
+				writeBytes(buf, "hello")
+				buf.WriteBitsLSB(uint64(ModeTerminated), 4)
+				writePadding(buf)
+			},
+			want: map[DecodeMode]string{
+				DecodeModeDefault: "hello",
+				DecodeModeStrict:  "hello",
+				DecodeModeLenient: "hello",
+			},
+		},
+		{
+			name: "format",
+			write: func(buf *bitstream.Buffer) {
+				writeBytes(buf, "hello")
+				buf.WriteBitsLSB(uint64(ModeTerminated), 4)
+				writePadding(buf)
+			},
+			damage:     true,
+			violations: []Violation{ViolationFormat},
+			want: map[DecodeMode]string{
+				DecodeModeDefault: "hello",
+				DecodeModeLenient: "hello",
+			},
+		},
+		{
+			name: "padding",
+			write: func(buf *bitstream.Buffer) {
+				writeBytes(buf, "hello")
+				buf.WriteBitsLSB(uint64(ModeTerminated), 4)
+			},
+			violations: []Violation{ViolationPadding},
+			want: map[DecodeMode]string{
+				DecodeModeDefault: "hello",
+				DecodeModeLenient: "hello",
+			},
+		},
+		{
+			name: "terminator",
+			write: func(buf *bitstream.Buffer) {
+				// the pad codewords follow the segment without the terminator.
+				// the first pad codeword is read as an unknown mode indicator.
+				writeBytes(buf, "hello")
+				for i := 0; buf.Len() < 19*8; i++ {
+					buf.WriteBitsLSB(uint64([]byte{0b1110_1100, 0b0001_0001}[i%2]), 8)
+				}
+			},
+			violations: []Violation{ViolationMode, ViolationTerminator, ViolationPadding},
+			want: map[DecodeMode]string{
+				DecodeModeLenient: "hello",
+			},
+		},
+		{
+			name: "character count",
+			write: func(buf *bitstream.Buffer) {
+				buf.WriteBitsLSB(uint64(ModeBytes), 4)
+				buf.WriteBitsLSB(30, 8)
+				for _, b := range []byte("abcdefghijklmnopqrstu") {
+					buf.WriteBitsLSB(uint64(b), 8)
+				}
+			},
+			violations: []Violation{ViolationCharacterCount},
+			want: map[DecodeMode]string{
+				DecodeModeLenient: "abcdefghijklmnopq",
+			},
+		},
+		{
+			name: "kanji",
+			write: func(buf *bitstream.Buffer) {
+				buf.WriteBitsLSB(uint64(ModeKanji), 4)
+				buf.WriteBitsLSB(2, 8)
+				buf.WriteBitsLSB(0x0d9f, 13) // 点
+				buf.WriteBitsLSB(0x1fff, 13) // out of range
+				buf.WriteBitsLSB(uint64(ModeTerminated), 4)
+				writePadding(buf)
+			},
+			violations: []Violation{ViolationKanji},
+			want: map[DecodeMode]string{
+				DecodeModeLenient: "点\ufffd",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		for _, mode := range []DecodeMode{DecodeModeDefault, DecodeModeStrict, DecodeModeLenient} {
+			img := encodeData(t, tt.write)
+			if tt.damage {
+				img.SetBinary(8, 0, !img.BinaryAt(8, 0))
+			}
+			qr, report, err := DecodeBitmapWithReport(img, WithDecodeMode(mode))
+			want, ok := tt.want[mode]
+			if !ok {
+				if err == nil {
+					t.Errorf("%s, mode %d: want error, got nil", tt.name, mode)
+				}
+				var verr *ViolationError
+				if mode == DecodeModeStrict && (!errors.As(err, &verr) || !reflect.DeepEqual(verr.Violations, tt.violations)) {
+					t.Errorf("%s, mode %d: unexpected error: %v", tt.name, mode, err)
+				}
+				continue
+			}
+			if err != nil {
+				t.Errorf("%s, mode %d: unexpected error: %v", tt.name, mode, err)
+				continue
+			}
+			var got []byte
+			for _, seg := range qr.Segments {
+				got = append(got, seg.Data...)
+			}
+			if string(got) != want {
+				t.Errorf("%s, mode %d: got %q, want %q", tt.name, mode, got, want)
+			}
+			if !reflect.DeepEqual(report.Violations, tt.violations) {
+				t.Errorf("%s, mode %d: got violations %v, want %v", tt.name, mode, report.Violations, tt.violations)
+			}
+		}
+	}
+}
+
+func TestViolationError(t *testing.T) {
+	err := &ViolationError{Violations: []Violation{ViolationTerminator, ViolationPadding}}
+	if got, want := err.Error(), "qrcode: the symbol breaks the rules: terminator, padding"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
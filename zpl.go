@@ -0,0 +1,105 @@
+package qrcode
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/shogo82148/qrcode/internal/bitstream"
+	"github.com/shogo82148/qrcode/internal/raster"
+)
+
+// EncodeZPL encodes QR Code into a ZPL label for Zebra label printers, and writes it to w.
+//
+// By default, the exact module matrix is embedded as a ^GF graphic field,
+// and the pixels of the image rendered in the same way as [QRCode.EncodePaletted] are the printer dots.
+//
+// If [WithNative] is enabled, the printer's ^BQ command is used instead for the symbol of a single segment.
+// The error correction level is kept, and the segment is sent in the manual input mode,
+// so that the printer doesn't change the segmentation.
+// The mixed modes of ^BQ need the structured append header, so the symbols of several segments are still sent as graphics.
+// The version is chosen by the printer, and the magnification is the module size rounded to 1-10 dots.
+// The mask is kept unless it is [MaskAuto], which is sent as the mask 7, the automatic selection of the printer.
+func (qr *QRCode) EncodeZPL(w io.Writer, opts ...EncodeOptions) error {
+	myopts := newEncodeOptions(opts...)
+	if !myopts.Native || len(qr.Segments) > 1 {
+		binimg, err := qr.EncodeToBitmap()
+		if err != nil {
+			return err
+		}
+		l, err := newLayout(binimg, myopts)
+		if err != nil {
+			return err
+		}
+		return raster.EncodeZPL(w, binimg, l)
+	}
+
+	if !qr.Level.IsValid() {
+		return errors.New("qrcode: invalid level")
+	}
+	if len(qr.Segments) == 0 {
+		return errors.New("qrcode: no segments")
+	}
+	magnification := min(10, max(1, round(myopts.ModuleSize)))
+
+	// the mask 7 lets the printer choose the mask.
+	mask := 7
+	if qr.Mask != MaskAuto && qr.Mask.IsValid() {
+		mask = int(qr.Mask)
+	}
+
+	// build the field data in the manual input mode.
+	seg := qr.Segments[0]
+	payload, err := printerPayload(seg)
+	if err != nil {
+		return err
+	}
+	data := fmt.Appendf(nil, "%sM,", qr.Level)
+	switch seg.Mode {
+	case ModeNumeric:
+		data = append(data, 'N')
+	case ModeAlphanumeric:
+		data = append(data, 'A')
+	case ModeBytes:
+		data = fmt.Appendf(data, "B%04d", len(payload))
+	case ModeKanji:
+		data = append(data, 'K')
+	default:
+		return fmt.Errorf("qrcode: unknown mode: %d", seg.Mode)
+	}
+	data = append(data, payload...)
+
+	qz := myopts.QuietZone * magnification
+	bw := bufio.NewWriter(w)
+	fmt.Fprintf(bw, "^XA\n^FO%d,%d^BQN,2,%d,%s,%d\n", qz, qz, magnification, qr.Level, mask)
+	bw.WriteString("^FH_^FD")
+	zplEscape(bw, data)
+	bw.WriteString("^FS\n^XZ\n")
+	return bw.Flush()
+}
+
+// printerPayload returns the bytes of seg that the printers encode.
+// The kanji are converted into Shift JIS.
+func printerPayload(seg Segment) ([]byte, error) {
+	if seg.Mode == ModeKanji {
+		return bitstream.ShiftJIS(seg.Data)
+	}
+	return seg.Data, nil
+}
+
+// zplEscape writes data in the field data.
+// The control characters and the characters that have special meanings in ZPL
+// are written as hexadecimal values with the indicator "_" of the ^FH command.
+func zplEscape(w *bufio.Writer, data []byte) {
+	const hex = "0123456789ABCDEF"
+	for _, b := range data {
+		if b < 0x20 || b >= 0x7f || b == '^' || b == '~' || b == '_' {
+			w.WriteByte('_')
+			w.WriteByte(hex[b>>4])
+			w.WriteByte(hex[b&0x0f])
+			continue
+		}
+		w.WriteByte(b)
+	}
+}
@@ -0,0 +1,90 @@
+package qrcode
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestEncodeZPL(t *testing.T) {
+	tests := []struct {
+		qr   *QRCode
+		want string
+	}{
+		{
+			qr: &QRCode{
+				Level: LevelQ,
+				Mask:  Mask3,
+				Segments: []Segment{
+					{Mode: ModeBytes, Data: []byte("a^b~c_d")},
+				},
+			},
+			want: "^XA\n^FO16,16^BQN,2,4,Q,3\n^FH_^FDQM,B0007a_5Eb_7Ec_5Fd^FS\n^XZ\n",
+		},
+		{
+			qr: &QRCode{
+				Level: LevelH,
+				Mask:  MaskAuto,
+				Segments: []Segment{
+					{Mode: ModeNumeric, Data: []byte("0123")},
+				},
+			},
+			want: "^XA\n^FO16,16^BQN,2,4,H,7\n^FH_^FDHM,N0123^FS\n^XZ\n",
+		},
+		{
+			qr: &QRCode{
+				Level: LevelM,
+				Mask:  MaskAuto,
+				Segments: []Segment{
+					{Mode: ModeKanji, Data: []byte("点")},
+				},
+			},
+			want: "^XA\n^FO16,16^BQN,2,4,M,7\n^FH_^FDMM,K_93_5F^FS\n^XZ\n",
+		},
+	}
+
+	for i, tt := range tests {
+		var buf bytes.Buffer
+		if err := tt.qr.EncodeZPL(&buf, WithNative(true), WithModuleSize(4)); err != nil {
+			t.Fatal(err)
+		}
+		if got := buf.String(); got != tt.want {
+			t.Errorf("%d: got %q, want %q", i, got, tt.want)
+		}
+	}
+}
+
+func TestEncodeZPL_Segments(t *testing.T) {
+	qr := &QRCode{
+		Version: 1,
+		Level:   LevelM,
+		Mask:    MaskAuto,
+		Segments: []Segment{
+			{Mode: ModeAlphanumeric, Data: []byte("ABC")},
+			{Mode: ModeKanji, Data: []byte("点")},
+		},
+	}
+
+	// the symbol of several segments is sent as a graphic even in the native mode.
+	var buf bytes.Buffer
+	if err := qr.EncodeZPL(&buf, WithNative(true), WithModuleSize(4)); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(buf.String(), "^XA\n^FO0,0^GFA,") {
+		t.Errorf("unexpected header: %q", buf.String()[:20])
+	}
+}
+
+func TestEncodeZPL_Graphic(t *testing.T) {
+	qr, err := New([]byte("https://github.com/shogo82148/qrcode"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if err := qr.EncodeZPL(&buf, WithModuleSize(4)); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(buf.String(), "^XA\n^FO0,0^GFA,") {
+		t.Errorf("unexpected header: %q", buf.String()[:20])
+	}
+}